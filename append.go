@@ -0,0 +1,171 @@
+package num2persian
+
+import (
+	"io"
+	"sync"
+)
+
+// AppendConvert appends the Persian text of an integer to dst and returns the
+// extended buffer. It does not allocate when dst has enough capacity.
+func AppendConvert(dst []byte, n int64) []byte {
+	return defaultConverter.AppendConvert(dst, n)
+}
+
+// AppendConvertUint64 appends the Persian text of a uint64 to dst and returns
+// the extended buffer.
+func AppendConvertUint64(dst []byte, n uint64) []byte {
+	return defaultConverter.AppendConvertUint64(dst, n)
+}
+
+// AppendOrdinal appends the Persian ordinal text of an integer to dst and
+// returns the extended buffer. Nothing is appended for n <= 0.
+func AppendOrdinal(dst []byte, n int64) []byte {
+	return defaultConverter.AppendOrdinal(dst, n)
+}
+
+// AppendToman appends the Persian text of an integer with "تومان" suffix to
+// dst and returns the extended buffer.
+func AppendToman(dst []byte, n int64) []byte {
+	return defaultConverter.AppendToman(dst, n)
+}
+
+// AppendRial appends the Persian text of an integer with "ریال" suffix to dst
+// and returns the extended buffer.
+func AppendRial(dst []byte, n int64) []byte {
+	return defaultConverter.AppendRial(dst, n)
+}
+
+// WriteConvert writes the Persian text of an integer to w, returning the
+// number of bytes written and any write error.
+func WriteConvert(w io.Writer, n int64) (int, error) {
+	return defaultConverter.WriteConvert(w, n)
+}
+
+// AppendConvert appends the text of an integer to dst.
+func (c *Converter) AppendConvert(dst []byte, n int64) []byte {
+	if n == 0 {
+		return append(dst, c.zero...)
+	}
+	if n < 0 {
+		dst = append(dst, c.negative...)
+		dst = append(dst, ' ')
+		return c.appendPositive(dst, uint64(-(n+1))+1)
+	}
+	return c.appendPositive(dst, uint64(n))
+}
+
+// AppendConvertUint64 appends the text of a uint64 to dst.
+func (c *Converter) AppendConvertUint64(dst []byte, n uint64) []byte {
+	if n == 0 {
+		return append(dst, c.zero...)
+	}
+	return c.appendPositive(dst, n)
+}
+
+// AppendOrdinal appends the ordinal text of an integer to dst. Nothing is
+// appended for n <= 0.
+func (c *Converter) AppendOrdinal(dst []byte, n int64) []byte {
+	if n <= 0 {
+		return dst
+	}
+	if n == 1 {
+		return append(dst, c.firstOrdinals[c.firstOrdinal]...)
+	}
+	start := len(dst)
+	return c.appendOrdinalSuffix(c.appendPositive(dst, uint64(n)), start)
+}
+
+// AppendToman appends the text of an integer with "تومان" suffix to dst.
+func (c *Converter) AppendToman(dst []byte, n int64) []byte {
+	dst = append(c.AppendConvert(dst, n), ' ')
+	return append(dst, c.toman...)
+}
+
+// AppendRial appends the text of an integer with "ریال" suffix to dst.
+func (c *Converter) AppendRial(dst []byte, n int64) []byte {
+	dst = append(c.AppendConvert(dst, n), ' ')
+	return append(dst, c.rial...)
+}
+
+// WriteConvert writes the text of an integer to w.
+func (c *Converter) WriteConvert(w io.Writer, n int64) (int, error) {
+	buf := bufPool.Get().(*[]byte)
+	*buf = c.AppendConvert((*buf)[:0], n)
+	written, err := w.Write(*buf)
+	bufPool.Put(buf)
+	return written, err
+}
+
+// bufPool holds the buffers used by WriteConvert. The text of any int64 fits
+// in the initial capacity.
+var bufPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 512)
+		return &b
+	},
+}
+
+// maxGroups is the number of three-digit groups in the largest uint64.
+const maxGroups = 7
+
+// appendPositive appends the text of n > 0. When scales are stacked, the
+// largest scale is not consumed: whatever is left above it is written as a
+// number followed by that scale.
+func (c *Converter) appendPositive(dst []byte, n uint64) []byte {
+	var groups [maxGroups]int
+	top := min(c.stackIndex(), maxGroups)
+	count := 0
+	for n > 0 && count < top {
+		groups[count] = int(n % 1000)
+		n /= 1000
+		count++
+	}
+
+	wrote := n > 0
+	if wrote {
+		if n == 1 {
+			dst = append(dst, c.scaleOne...)
+		} else {
+			dst = c.appendPositive(dst, n)
+		}
+		dst = append(dst, ' ')
+		dst = c.appendScale(dst, top)
+	}
+	for i := count - 1; i >= 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+		if wrote {
+			dst = append(dst, c.separator...)
+		}
+		dst = c.appendGroupWithScale(dst, groups[i], i)
+		wrote = true
+	}
+	return dst
+}
+
+// appendGroupWithScale appends a non-zero group followed by its scale word.
+func (c *Converter) appendGroupWithScale(dst []byte, group, scaleIndex int) []byte {
+	if scaleIndex == 1 && group == 1 && !c.oneThousand {
+		return c.appendScale(dst, 1)
+	}
+	if scaleIndex > 0 && group == 1 {
+		dst = append(dst, c.scaleOne...)
+	} else {
+		dst = append(dst, c.groupWords[group]...)
+	}
+	if scaleIndex > 0 {
+		dst = append(dst, ' ')
+		dst = c.appendScale(dst, scaleIndex)
+	}
+	return dst
+}
+
+// buildGroupTable precomputes the text of every group from 0 to 999.
+func (c *Converter) buildGroupTable() *[1000]string {
+	var table [1000]string
+	for n := range table {
+		table[n] = c.convertGroup(n)
+	}
+	return &table
+}
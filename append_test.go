@@ -0,0 +1,142 @@
+package num2persian
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+)
+
+func TestAppendConvert(t *testing.T) {
+	tests := []int64{
+		0, 1, -1, 21, 1000, 1001, 1000000, 1234567, -500,
+		math.MaxInt64, math.MinInt64,
+	}
+
+	for _, n := range tests {
+		prefix := []byte("x")
+		result := string(AppendConvert(prefix, n))
+		if expected := "x" + Convert(n); result != expected {
+			t.Errorf("AppendConvert(%d) = %q, want %q", n, result, expected)
+		}
+	}
+}
+
+func TestAppendConvertUint64(t *testing.T) {
+	for _, n := range []uint64{0, 7, math.MaxUint64} {
+		if result, expected := string(AppendConvertUint64(nil, n)), ConvertUint64(n); result != expected {
+			t.Errorf("AppendConvertUint64(%d) = %q, want %q", n, result, expected)
+		}
+	}
+}
+
+func TestAppendOrdinal(t *testing.T) {
+	for _, n := range []int64{-1, 0, 1, 2, 3, 23, 30, 1000, 1003, math.MaxInt64} {
+		if result, expected := string(AppendOrdinal([]byte("x"), n)), "x"+ConvertOrdinal(n); result != expected {
+			t.Errorf("AppendOrdinal(%d) = %q, want %q", n, result, expected)
+		}
+	}
+}
+
+func TestAppendCurrency(t *testing.T) {
+	if result, expected := string(AppendToman(nil, 1500000)), ToToman(1500000); result != expected {
+		t.Errorf("AppendToman(1500000) = %q, want %q", result, expected)
+	}
+	if result, expected := string(AppendRial(nil, -5000)), ToRial(-5000); result != expected {
+		t.Errorf("AppendRial(-5000) = %q, want %q", result, expected)
+	}
+}
+
+func TestAppendConvert_Converter(t *testing.T) {
+	c := NewConverter(Options{Separator: "، ", OneThousand: true, Scales: []string{"", "K"}})
+	for _, n := range []int64{1000, 1001001, math.MinInt64} {
+		if result, expected := string(c.AppendConvert(nil, n)), c.Convert(n); result != expected {
+			t.Errorf("AppendConvert(%d) = %q, want %q", n, result, expected)
+		}
+	}
+}
+
+func TestAppend_NoAllocations(t *testing.T) {
+	buf := make([]byte, 0, 512)
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"AppendConvert", func() { buf = AppendConvert(buf[:0], math.MinInt64) }},
+		{"AppendConvertUint64", func() { buf = AppendConvertUint64(buf[:0], math.MaxUint64) }},
+		{"AppendOrdinal", func() { buf = AppendOrdinal(buf[:0], 1234567) }},
+		{"AppendOrdinal irregular", func() { buf = AppendOrdinal(buf[:0], 1003) }},
+		{"AppendToman", func() { buf = AppendToman(buf[:0], 1500000) }},
+		{"AppendRial", func() { buf = AppendRial(buf[:0], 15000000) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tt.fn); allocs != 0 {
+				t.Errorf("%s allocates %v times, want 0", tt.name, allocs)
+			}
+		})
+	}
+}
+
+func TestWriteConvert(t *testing.T) {
+	var b bytes.Buffer
+	n, err := WriteConvert(&b, 1234)
+	if err != nil {
+		t.Fatalf("WriteConvert(1234) error: %v", err)
+	}
+	expected := Convert(1234)
+	if b.String() != expected || n != len(expected) {
+		t.Errorf("WriteConvert(1234) wrote %q (%d bytes), want %q (%d bytes)", b.String(), n, expected, len(expected))
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestWriteConvert_Error(t *testing.T) {
+	if _, err := WriteConvert(failingWriter{}, 1); err == nil {
+		t.Error("WriteConvert to a failing writer returned no error")
+	}
+}
+
+func BenchmarkAppendConvert_Small(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 512)
+	for i := 0; i < b.N; i++ {
+		buf = AppendConvert(buf[:0], 42)
+	}
+}
+
+func BenchmarkAppendConvert_Large(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 512)
+	for i := 0; i < b.N; i++ {
+		buf = AppendConvert(buf[:0], math.MaxInt64)
+	}
+}
+
+func BenchmarkAppendOrdinal(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 512)
+	for i := 0; i < b.N; i++ {
+		buf = AppendOrdinal(buf[:0], 1234567)
+	}
+}
+
+func BenchmarkAppendToman(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]byte, 0, 512)
+	for i := 0; i < b.N; i++ {
+		buf = AppendToman(buf[:0], 1500000)
+	}
+}
+
+func BenchmarkWriteConvert(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		WriteConvert(io.Discard, math.MaxInt64)
+	}
+}
@@ -0,0 +1,177 @@
+package num2persian
+
+import "strings"
+
+// Options configures a Converter. Zero values select the defaults of the
+// locale.
+type Options struct {
+	// Locale selects the vocabulary. Default Persian.
+	Locale Locale
+	// Script selects a Latin transliteration of the Persian locale; Dari and
+	// Tajik are always written in their native script.
+	Script Script
+	// Separator joins the parts of a number. Default " و ".
+	Separator string
+	// Negative is written before negative numbers. Default "منفی".
+	Negative string
+	// Zero is the word for zero. Default "صفر".
+	Zero string
+	// DecimalPoint is read between the integer part and the fraction in the
+	// DecimalPoint style. Default "ممیز".
+	DecimalPoint string
+	// Scales names the powers of 1000: Scales[i] is the name of 1000^i and
+	// Scales[0] is unused. Numbers beyond a custom table are written by
+	// stacking its last name. A table with fewer than two entries selects the
+	// default, which is extended with generated names such as "آندسیلیون".
+	Scales []string
+	// ScaleSystem selects how powers of 1000 are named. It is ignored when
+	// Scales is set. Default IranianScale.
+	ScaleSystem ScaleSystem
+	// StackScales writes numbers beyond the default scales table by repeating
+	// its largest name, as in "هزار دسیلیون", instead of generating names.
+	StackScales bool
+	// Colloquial writes the Persian locale in spoken Tehrani forms, such as
+	// "پونصد", "صد و بیس" and "یه میلیون". Cheques stay formal. Other
+	// locales and scripts ignore it.
+	Colloquial bool
+	// OneThousand writes "یک هزار" instead of "هزار" for one thousand. Dari
+	// always does.
+	OneThousand bool
+	// DecimalStyle selects how fractions are read.
+	DecimalStyle DecimalStyle
+	// FirstOrdinal selects the ordinal words for one. Default اول/اولین.
+	FirstOrdinal FirstOrdinal
+}
+
+// Converter converts numbers to Persian text with its own vocabulary and
+// options. A Converter is immutable and safe for concurrent use; the
+// package-level functions use a Converter with the default options.
+type Converter struct {
+	zero         string
+	negative     string
+	separator    string
+	decimalPoint string
+	notANumber   string
+	infinity     string
+
+	ones     []string
+	teens    []string
+	tens     []string
+	hundreds []string
+	// finalTens are the tens written at the end of a group.
+	finalTens []string
+	scales    []string
+	// scaleOne is the word for one before a scale.
+	scaleOne string
+
+	// names generates the scales beyond the table; nil for custom tables.
+	names       *scaleNamer
+	scaleSystem ScaleSystem
+	stackScales bool
+
+	// groupWords holds the text of every group from 0 to 999.
+	groupWords *[1000]string
+
+	oneThousand  bool
+	decimalStyle DecimalStyle
+	firstOrdinal FirstOrdinal
+
+	firstOrdinals []string
+	ordinals      *ordinalRules
+	joiner        string
+	currency      string
+	subunit       string
+	toman         string
+	rial          string
+	chequePrefix  string
+	chequeSuffix  string
+
+	words map[string]wordInfo
+
+	// formal is the Converter without the colloquial style, which cheques
+	// are written with; nil when the Converter is formal.
+	formal *Converter
+}
+
+var defaultConverter = NewConverter(Options{})
+
+// NewConverter returns a Converter configured by opts.
+func NewConverter(opts Options) *Converter {
+	locale := opts.Locale
+	if locale < 0 || int(locale) >= len(vocabularies) {
+		locale = Persian
+	}
+	v := vocabularies[locale]
+	if locale == Persian && opts.Script > NativeScript && int(opts.Script) < len(transliterations) {
+		v = transliterations[opts.Script]
+	} else if locale == Persian && opts.Colloquial {
+		v = colloquialVocabulary
+	}
+
+	c := &Converter{
+		zero:          orDefault(opts.Zero, v.zero),
+		negative:      orDefault(opts.Negative, v.negative),
+		separator:     orDefault(opts.Separator, v.separator),
+		decimalPoint:  orDefault(opts.DecimalPoint, v.decimalPoint),
+		notANumber:    v.notANumber,
+		infinity:      v.infinity,
+		ones:          v.ones,
+		teens:         v.teens,
+		tens:          v.tens,
+		hundreds:      v.hundreds,
+		finalTens:     v.finalTens,
+		scales:        v.scales,
+		scaleOne:      orDefault(v.scaleOne, v.ones[1]),
+		names:         v.names,
+		scaleSystem:   opts.ScaleSystem,
+		stackScales:   opts.StackScales,
+		oneThousand:   opts.OneThousand || v.oneThousand,
+		decimalStyle:  opts.DecimalStyle,
+		firstOrdinal:  opts.FirstOrdinal,
+		firstOrdinals: v.firstOrdinals,
+		ordinals:      v.ordinals,
+		joiner:        v.joiner,
+		currency:      v.currency,
+		subunit:       v.subunit,
+		toman:         v.toman,
+		rial:          v.rial,
+		chequePrefix:  orDefault(v.chequePrefix, chequePrefix),
+		chequeSuffix:  orDefault(v.chequeSuffix, chequeSuffix),
+	}
+	if c.firstOrdinal < 0 || int(c.firstOrdinal) >= len(c.firstOrdinals) {
+		c.firstOrdinal = FirstAvval
+	}
+	switch c.scaleSystem {
+	case ShortScale, LongScale:
+		c.scales = c.names.table(v.scales[1], len(v.scales), c.scaleSystem)
+	default:
+		c.scaleSystem = IranianScale
+	}
+	if len(opts.Scales) >= 2 {
+		c.scales = append([]string(nil), opts.Scales...)
+		c.names = nil
+	}
+	if c.finalTens == nil {
+		c.finalTens = c.tens
+	}
+	c.groupWords = c.buildGroupTable()
+	c.words = c.buildWordTable()
+	if v == colloquialVocabulary {
+		formal := opts
+		formal.Colloquial = false
+		c.formal = NewConverter(formal)
+	}
+	return c
+}
+
+// connector is the separator as it appears between whitespace-separated words.
+func (c *Converter) connector() string {
+	return strings.TrimSpace(c.separator)
+}
+
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,171 @@
+package num2persian
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestNewConverter_Defaults(t *testing.T) {
+	c := NewConverter(Options{})
+	for _, n := range []int64{0, 7, 1000, 1234567, -42, math.MaxInt64, math.MinInt64} {
+		if result, expected := c.Convert(n), Convert(n); result != expected {
+			t.Errorf("Converter.Convert(%d) = %q, want %q", n, result, expected)
+		}
+	}
+}
+
+func TestConverter_Options(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     Options
+		convert  func(c *Converter) string
+		expected string
+	}{
+		{"OneThousand", Options{OneThousand: true}, func(c *Converter) string { return c.Convert(1000) }, "یک هزار"},
+		{"OneThousandCompound", Options{OneThousand: true}, func(c *Converter) string { return c.Convert(1001000) }, "یک میلیون و یک هزار"},
+		{"Separator", Options{Separator: " ، "}, func(c *Converter) string { return c.Convert(1234) }, "هزار ، دویست ، سی ، چهار"},
+		{"Negative", Options{Negative: "منهای"}, func(c *Converter) string { return c.Convert(-5) }, "منهای پنج"},
+		{"Zero", Options{Zero: "هیچ"}, func(c *Converter) string { return c.Convert(0) }, "هیچ"},
+		{"DecimalPoint", Options{DecimalPoint: "ممیزِ"}, func(c *Converter) string { return c.ConvertFloat(1.5, 1) }, "یک ممیزِ پنج"},
+		{"DecimalStyle", Options{DecimalStyle: DecimalFraction}, func(c *Converter) string { return c.ConvertFloat(1.05, 2) }, "یک و پنج صدم"},
+		{"Scales", Options{Scales: []string{"", "هزار", "میلیون"}}, func(c *Converter) string { return c.Convert(2000000000) }, "دو هزار میلیون"},
+		{"Ordinal", Options{OneThousand: true}, func(c *Converter) string { return c.ConvertOrdinal(1000) }, "یک هزارم"},
+		{"Toman", Options{OneThousand: true}, func(c *Converter) string { return c.ToToman(1500) }, "یک هزار و پانصد تومان"},
+		{"Rial", Options{Negative: "منهای"}, func(c *Converter) string { return c.ToRial(-10) }, "منهای ده ریال"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.convert(NewConverter(tt.opts))
+			if result != tt.expected {
+				t.Errorf("got %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConverter_ScalesAreCopied(t *testing.T) {
+	table := []string{"", "هزار", "میلیون"}
+	c := NewConverter(Options{Scales: table})
+	table[1] = "تغییر"
+	if result := c.Convert(2000); result != "دو هزار" {
+		t.Errorf("Convert(2000) = %q after modifying Options.Scales, want %q", result, "دو هزار")
+	}
+}
+
+func TestConverter_ParseRoundTrip(t *testing.T) {
+	c := NewConverter(Options{OneThousand: true, Scales: []string{"", "هزار", "میلیون"}})
+	n, _ := new(big.Int).SetString("123456789012345", 10)
+	text := c.ConvertBigInt(n)
+	result, err := c.ParseBigInt(text)
+	if err != nil {
+		t.Fatalf("ParseBigInt(%q) unexpected error: %v", text, err)
+	}
+	if result.Cmp(n) != 0 {
+		t.Errorf("ParseBigInt(%q) = %s, want %s", text, result, n)
+	}
+}
+
+func TestConverter_Concurrent(t *testing.T) {
+	c := NewConverter(Options{OneThousand: true})
+	done := make(chan bool)
+	for i := 0; i < 8; i++ {
+		go func(n int64) {
+			for j := int64(0); j < 1000; j++ {
+				c.Convert(n*1000 + j)
+				Convert(n*1000 + j)
+			}
+			done <- true
+		}(int64(i))
+	}
+	for i := 0; i < 8; i++ {
+		<-done
+	}
+}
+
+func TestConverter_Colloquial(t *testing.T) {
+	c := NewConverter(Options{Colloquial: true})
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"500", c.Convert(500), "پونصد"},
+		{"120", c.Convert(120), "صد و بیس"},
+		{"17", c.Convert(17), "هیفده"},
+		{"18", c.Convert(18), "هیجده"},
+		{"16", c.Convert(16), "شونزده"},
+		{"454", c.Convert(454), "چارصد و پنجاه و چار"},
+		{"450", c.Convert(450), "چارصد و پنجا"},
+		{"706", c.Convert(706), "هفصد و شیش"},
+		{"1", c.Convert(1), "یک"},
+		{"20", c.Convert(20), "بیس"},
+		{"21", c.Convert(21), "بیست و یک"},
+		{"25", c.Convert(25), "بیست و پنج"},
+		{"50", c.Convert(50), "پنجا"},
+		{"55", c.Convert(55), "پنجاه و پنج"},
+		{"20050", c.Convert(20050), "بیس هزار و پنجا"},
+		{"ordinal 21", c.ConvertOrdinal(21), "بیست و یکم"},
+		{"1000", c.Convert(1000), "هزار"},
+		{"1000000", c.Convert(1000000), "یه میلیون"},
+		{"1001001", c.Convert(1001001), "یه میلیون و هزار و یک"},
+		{"1500000 toman", c.ToToman(1500000), "یه میلیون و پونصد هزار تومان"},
+		{"OneThousand", NewConverter(Options{Colloquial: true, OneThousand: true}).Convert(1000), "یه هزار"},
+		{"stacked", NewConverter(Options{Colloquial: true, Scales: []string{"", "هزار", "میلیون"}}).Convert(1000000000000), "یه میلیون میلیون"},
+		{"BigInt", c.ConvertBigInt(new(big.Int).Exp(big.NewInt(1000), big.NewInt(12), nil)), "یه آندسیلیون"},
+		{"ordinal 20", c.ConvertOrdinal(20), "بیستم"},
+		{"ordinal 50", c.ConvertOrdinal(50), "پنجاهم"},
+		{"ordinal 15", c.ConvertOrdinal(15), "پونزدهم"},
+		{"Dari", NewConverter(Options{Locale: Dari, Colloquial: true}).Convert(500), "پنج صد"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConverter_ColloquialCheque(t *testing.T) {
+	c := NewConverter(Options{Colloquial: true})
+	tests := []struct {
+		result   string
+		expected string
+	}{
+		{c.ToRialCheque(1000), "فقط یک هزار ریال تمام"},
+		{c.ToRialCheque(1000000), "فقط یک میلیون ریال تمام"},
+		{c.ToTomanCheque(1564020), "فقط یک میلیون و پانصد و شصت و چهار هزار و بیست تومان تمام"},
+		{c.ToRialCheque(-18), "فقط منفی هجده ریال تمام"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+	if result, expected := c.Convert(1000000), "یه میلیون"; result != expected {
+		t.Errorf("Convert(1000000) = %q after a cheque, want %q", result, expected)
+	}
+}
+
+func TestConverter_ColloquialParseRoundTrip(t *testing.T) {
+	c := NewConverter(Options{Colloquial: true})
+	for n := int64(-2000); n <= 1002000; n += 13 {
+		text := c.Convert(n)
+		result, err := c.Parse(text)
+		if err != nil {
+			t.Fatalf("Parse(%q) unexpected error: %v", text, err)
+		}
+		if result != n {
+			t.Fatalf("Parse(%q) = %d, want %d", text, result, n)
+		}
+	}
+	if result, err := c.Parse("یه میلیون و پونصد هزار"); err != nil || result != 1500000 {
+		t.Errorf("Parse(\"یه میلیون و پونصد هزار\") = %d, %v, want 1500000", result, err)
+	}
+}
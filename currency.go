@@ -3,34 +3,134 @@ package num2persian
 const (
 	tomanUnit = "تومان"
 	rialUnit  = "ریال"
+
+	chequePrefix = "فقط"
+	chequeSuffix = "تمام"
 )
 
 // ToToman converts a number to Persian text with "تومان" suffix.
 func ToToman(n int64) string {
-	return Convert(n) + " " + tomanUnit
+	return defaultConverter.ToToman(n)
 }
 
 // ToTomanInt converts an int to Persian text with "تومان" suffix.
 func ToTomanInt(n int) string {
-	return ToToman(int64(n))
+	return defaultConverter.ToTomanInt(n)
 }
 
 // ToRial converts a number to Persian text with "ریال" suffix.
 func ToRial(n int64) string {
-	return Convert(n) + " " + rialUnit
+	return defaultConverter.ToRial(n)
 }
 
 // ToRialInt converts an int to Persian text with "ریال" suffix.
 func ToRialInt(n int) string {
-	return ToRial(int64(n))
+	return defaultConverter.ToRialInt(n)
+}
+
+// ToTomanUint64 converts a uint64 to Persian text with "تومان" suffix.
+func ToTomanUint64(n uint64) string {
+	return defaultConverter.ToTomanUint64(n)
+}
+
+// ToRialUint64 converts a uint64 to Persian text with "ریال" suffix.
+func ToRialUint64(n uint64) string {
+	return defaultConverter.ToRialUint64(n)
 }
 
 // TomanToRial converts Toman to Rial and returns Persian text.
 func TomanToRial(n int64) string {
-	return ToRial(n * 10)
+	return defaultConverter.TomanToRial(n)
 }
 
 // RialToToman converts Rial to Toman and returns Persian text.
 func RialToToman(n int64) string {
-	return ToToman(n / 10)
+	return defaultConverter.RialToToman(n)
+}
+
+// ToRialCheque converts a number to Persian text in the wording required on
+// bank cheques and payment orders: "یک هزار" is written in full and the amount
+// is wrapped as "فقط ... ریال تمام".
+func ToRialCheque(n int64) string {
+	return defaultConverter.ToRialCheque(n)
+}
+
+// ToTomanCheque is like ToRialCheque but with the "تومان" unit.
+func ToTomanCheque(n int64) string {
+	return defaultConverter.ToTomanCheque(n)
+}
+
+// ToToman converts a number to text with "تومان" suffix.
+func (c *Converter) ToToman(n int64) string {
+	return c.Convert(n) + " " + c.toman
+}
+
+// ToTomanInt converts an int to text with "تومان" suffix.
+func (c *Converter) ToTomanInt(n int) string {
+	return c.ToToman(int64(n))
+}
+
+// ToRial converts a number to text with "ریال" suffix.
+func (c *Converter) ToRial(n int64) string {
+	return c.Convert(n) + " " + c.rial
+}
+
+// ToRialInt converts an int to text with "ریال" suffix.
+func (c *Converter) ToRialInt(n int) string {
+	return c.ToRial(int64(n))
+}
+
+// ToTomanUint64 converts a uint64 to text with "تومان" suffix.
+func (c *Converter) ToTomanUint64(n uint64) string {
+	return c.ConvertUint64(n) + " " + c.toman
+}
+
+// ToRialUint64 converts a uint64 to text with "ریال" suffix.
+func (c *Converter) ToRialUint64(n uint64) string {
+	return c.ConvertUint64(n) + " " + c.rial
+}
+
+// ToCurrency converts a number to text followed by the currency of the
+// Converter's locale: "تومان" in Persian and "افغانی" in Dari.
+func (c *Converter) ToCurrency(n int64) string {
+	return c.Convert(n) + " " + c.currency
+}
+
+// ToSubunit converts a number to text followed by the currency subunit of
+// the Converter's locale: "ریال" in Persian and "پول" in Dari.
+func (c *Converter) ToSubunit(n int64) string {
+	return c.Convert(n) + " " + c.subunit
+}
+
+// TomanToRial converts Toman to Rial and returns text.
+func (c *Converter) TomanToRial(n int64) string {
+	return c.ToRial(n * 10)
+}
+
+// RialToToman converts Rial to Toman and returns text.
+func (c *Converter) RialToToman(n int64) string {
+	return c.ToToman(n / 10)
+}
+
+// ToRialCheque converts a number to text in the cheque wording of the
+// Converter's vocabulary with the "ریال" unit, writing "یک هزار" in full
+// regardless of the Converter's options.
+func (c *Converter) ToRialCheque(n int64) string {
+	return c.cheque(n, c.rial)
+}
+
+// ToTomanCheque converts a number to text in cheque wording with the
+// "تومان" unit.
+func (c *Converter) ToTomanCheque(n int64) string {
+	return c.cheque(n, c.toman)
+}
+
+// cheque writes n in cheque wording, which is always formal.
+func (c *Converter) cheque(n int64, unit string) string {
+	formal := *c
+	if c.formal != nil {
+		formal = *c.formal
+	}
+	formal.oneThousand = true
+	return c.chequePrefix + " " + formal.Convert(n) + " " + unit + " " + c.chequeSuffix
 }
@@ -62,6 +62,22 @@ func TestToRialInt(t *testing.T) {
 	}
 }
 
+func TestToTomanUint64(t *testing.T) {
+	result := ToTomanUint64(10000000000000000000)
+	expected := "ده کوینتیلیون تومان"
+	if result != expected {
+		t.Errorf("ToTomanUint64(10000000000000000000) = %q, want %q", result, expected)
+	}
+}
+
+func TestToRialUint64(t *testing.T) {
+	result := ToRialUint64(0)
+	expected := "صفر ریال"
+	if result != expected {
+		t.Errorf("ToRialUint64(0) = %q, want %q", result, expected)
+	}
+}
+
 func TestTomanToRial(t *testing.T) {
 	result := TomanToRial(1000)
 	expected := "ده هزار ریال"
@@ -77,3 +93,42 @@ func TestRialToToman(t *testing.T) {
 		t.Errorf("RialToToman(10000) = %q, want %q", result, expected)
 	}
 }
+
+func TestToRialCheque(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "فقط صفر ریال تمام"},
+		{1000, "فقط یک هزار ریال تمام"},
+		{1500, "فقط یک هزار و پانصد ریال تمام"},
+		{1001000, "فقط یک میلیون و یک هزار ریال تمام"},
+		{2000000, "فقط دو میلیون ریال تمام"},
+		{15000000, "فقط پانزده میلیون ریال تمام"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := ToRialCheque(tt.input)
+			if result != tt.expected {
+				t.Errorf("ToRialCheque(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToTomanCheque(t *testing.T) {
+	result := ToTomanCheque(1001000)
+	expected := "فقط یک میلیون و یک هزار تومان تمام"
+	if result != expected {
+		t.Errorf("ToTomanCheque(1001000) = %q, want %q", result, expected)
+	}
+}
+
+func TestToRialCheque_DoesNotChangeConverter(t *testing.T) {
+	c := NewConverter(Options{})
+	c.ToRialCheque(1000)
+	if result := c.ToRial(1000); result != "هزار ریال" {
+		t.Errorf("ToRial(1000) = %q after ToRialCheque, want %q", result, "هزار ریال")
+	}
+}
@@ -0,0 +1,187 @@
+package num2persian
+
+import (
+	"math"
+	"math/big"
+	"strings"
+)
+
+// DecimalStyle selects how the fractional part of a number is read.
+type DecimalStyle int
+
+const (
+	// DecimalPoint reads the fraction as a number after "ممیز", spelling out
+	// its leading zeros: 1.05 is "یک ممیز صفر پنج".
+	DecimalPoint DecimalStyle = iota
+	// DecimalFraction reads the fraction with its place value, the
+	// traditional Persian style: 1.05 is "یک و پنج صدم".
+	DecimalFraction
+)
+
+// ConvertDecimal converts a decimal string such as "12345678901234567.89"
+// to Persian text without going through float64, so every digit of the
+// integer and fractional parts is kept. It accepts the same digits and
+// separators as ConvertString, but no exponent.
+func ConvertDecimal(s string) (string, error) {
+	return defaultConverter.ConvertDecimal(s)
+}
+
+// ConvertBigFloat converts a big.Float to Persian text with specified decimal
+// precision, using the same wording as ConvertFloat.
+func ConvertBigFloat(f *big.Float, precision int) string {
+	return defaultConverter.ConvertBigFloat(f, precision)
+}
+
+// ConvertRat converts a big.Rat to Persian text rounded to the specified
+// decimal precision, using the same wording as ConvertFloat.
+func ConvertRat(r *big.Rat, precision int) string {
+	return defaultConverter.ConvertRat(r, precision)
+}
+
+// ConvertRatFraction converts a big.Rat to Persian text as an exact fraction
+// in lowest terms, e.g. 17/5 becomes "سه و دو پنجم".
+func ConvertRatFraction(r *big.Rat) string {
+	return defaultConverter.ConvertRatFraction(r)
+}
+
+// ConvertDecimal converts a decimal string to text without going through
+// float64, reading the fraction in the Converter's decimal style.
+func (c *Converter) ConvertDecimal(s string) (string, error) {
+	input := strings.TrimSpace(s)
+	s, reason := normalizeNumber(s)
+	if reason != "" {
+		return "", &ParseError{Input: input, Reason: reason}
+	}
+	text, ok := c.decimalText(s, c.decimalStyle)
+	if !ok {
+		return "", &ParseError{Input: input}
+	}
+	return text, nil
+}
+
+// ConvertBigFloat converts a big.Float to text with specified decimal
+// precision.
+func (c *Converter) ConvertBigFloat(f *big.Float, precision int) string {
+	if f == nil {
+		return c.zero
+	}
+	if f.IsInf() {
+		return c.ConvertFloat(math.Inf(f.Sign()), precision)
+	}
+	if precision < 0 {
+		precision = 0
+	}
+	text, _ := c.decimalText(f.Text('f', precision), c.decimalStyle)
+	return text
+}
+
+// ConvertRat converts a big.Rat to text rounded to the specified decimal
+// precision.
+func (c *Converter) ConvertRat(r *big.Rat, precision int) string {
+	if r == nil {
+		return c.zero
+	}
+	if precision < 0 {
+		precision = 0
+	}
+	text, _ := c.decimalText(r.FloatString(precision), c.decimalStyle)
+	return text
+}
+
+// ConvertRatFraction converts a big.Rat to text as an exact fraction in
+// lowest terms.
+func (c *Converter) ConvertRatFraction(r *big.Rat) string {
+	if r == nil || r.Sign() == 0 {
+		return c.zero
+	}
+	if r.Sign() < 0 {
+		return c.negative + " " + c.ConvertRatFraction(new(big.Rat).Neg(r))
+	}
+	if r.IsInt() {
+		return c.convertBigIntPositive(r.Num())
+	}
+
+	intPart, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	text := c.convertBigIntPositive(remainder) + " " + c.ordinalSuffix(c.denominatorText(r.Denom()))
+	if intPart.Sign() > 0 {
+		text = c.convertBigIntPositive(intPart) + c.separator + text
+	}
+	return text
+}
+
+// decimalText converts a normalized decimal of the form "[-]digits[.digits]".
+// The sign is dropped when every digit is zero.
+func (c *Converter) decimalText(s string, style DecimalStyle) (string, bool) {
+	isNegative := strings.HasPrefix(s, "-")
+	if isNegative {
+		s = s[1:]
+	}
+	intPart, frac, hasFrac := strings.Cut(s, ".")
+	if intPart == "" && (!hasFrac || frac == "") {
+		return "", false
+	}
+	if !allDigits(intPart) || !allDigits(frac) {
+		return "", false
+	}
+
+	intWords := c.zero
+	if digits := strings.TrimLeft(intPart, "0"); digits != "" {
+		intWords = c.convertDigits(digits)
+	}
+	text := c.formatDecimal(intWords, frac, style)
+	if isNegative && (intWords != c.zero || strings.Trim(frac, "0") != "") {
+		text = c.negative + " " + text
+	}
+	return text, true
+}
+
+// formatDecimal joins the words of an integer part with the fractional
+// digits frac. An empty frac leaves the integer part unchanged.
+func (c *Converter) formatDecimal(intWords string, frac string, style DecimalStyle) string {
+	if frac == "" {
+		return intWords
+	}
+	digits := strings.TrimLeft(frac, "0")
+
+	if style == DecimalFraction {
+		if digits == "" {
+			return intWords
+		}
+		text := c.convertDigits(digits) + " " + c.fractionDenominator(len(frac))
+		if intWords == c.zero {
+			return text
+		}
+		return intWords + c.separator + text
+	}
+
+	if digits == "" {
+		return intWords + " " + c.decimalPoint + " " + c.zero
+	}
+	leading := strings.Repeat(c.zero+" ", len(frac)-len(digits))
+	return intWords + " " + c.decimalPoint + " " + leading + c.convertDigits(digits)
+}
+
+// fractionDenominator names the place value of the last of places
+// fractional digits: دهم, صدم, هزارم, ده‌هزارم, ...
+func (c *Converter) fractionDenominator(places int) string {
+	power := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	return c.ordinalSuffix(strings.ReplaceAll(c.denominatorText(power), " ", c.joiner))
+}
+
+// denominatorText converts a denominator to cardinal text, dropping the
+// leading one of a single scale: 10^6 is read "میلیونم", not "یک میلیونم".
+func (c *Converter) denominatorText(n *big.Int) string {
+	text := c.convertBigIntPositive(n)
+	for _, one := range []string{c.ones[1], c.scaleOne} {
+		if rest, ok := strings.CutPrefix(text, one+" "); ok && !strings.Contains(rest, c.separator) {
+			return rest
+		}
+	}
+	return text
+}
+
+// convertDigits converts a string of ASCII digits without leading zeros.
+func (c *Converter) convertDigits(digits string) string {
+	n, _ := new(big.Int).SetString(digits, 10)
+	return c.convertBigIntPositive(n)
+}
@@ -0,0 +1,244 @@
+package num2persian
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestConvertFloat_LeadingZeros(t *testing.T) {
+	tests := []struct {
+		input     float64
+		precision int
+		expected  string
+	}{
+		{1.05, 2, "یک ممیز صفر پنج"},
+		{0.001, 3, "صفر ممیز صفر صفر یک"},
+		{2.5, 2, "دو ممیز پنجاه"},
+		{1.0, 2, "یک ممیز صفر"},
+		{-3.07, 2, "منفی سه ممیز صفر هفت"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := ConvertFloat(tt.input, tt.precision)
+			if result != tt.expected {
+				t.Errorf("ConvertFloat(%v, %d) = %q, want %q", tt.input, tt.precision, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertFloatWithStyle_Fraction(t *testing.T) {
+	tests := []struct {
+		input     float64
+		precision int
+		expected  string
+	}{
+		{1.05, 2, "یک و پنج صدم"},
+		{1.5, 1, "یک و پنج دهم"},
+		{1.5, 2, "یک و پنجاه صدم"},
+		{0.25, 2, "بیست و پنج صدم"},
+		{3.125, 3, "سه و صد و بیست و پنج هزارم"},
+		{0.0001, 4, "یک ده‌هزارم"},
+		{0.00005, 5, "پنج صد‌هزارم"},
+		{0.000001, 6, "یک میلیونم"},
+		{-12.5, 1, "منفی دوازده و پنج دهم"},
+		{7.0, 2, "هفت"},
+		{0.0, 2, "صفر"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := ConvertFloatWithStyle(tt.input, tt.precision, DecimalFraction)
+			if result != tt.expected {
+				t.Errorf("ConvertFloatWithStyle(%v, %d, DecimalFraction) = %q, want %q", tt.input, tt.precision, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertStringWithStyle(t *testing.T) {
+	tests := []struct {
+		input    string
+		style    DecimalStyle
+		expected string
+	}{
+		{"1.05", DecimalPoint, "یک ممیز صفر پنج"},
+		{"1.05", DecimalFraction, "یک و پنج صدم"},
+		{"۰٫۰۷", DecimalFraction, "هفت صدم"},
+		{"42", DecimalFraction, "چهل و دو"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result, err := ConvertStringWithStyle(tt.input, tt.style)
+			if err != nil {
+				t.Fatalf("ConvertStringWithStyle(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ConvertStringWithStyle(%q, %d) = %q, want %q", tt.input, tt.style, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertDecimal(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0", "صفر"},
+		{"12.5", "دوازده ممیز پنج"},
+		{"-0.00", "صفر ممیز صفر"},
+		{".5", "صفر ممیز پنج"},
+		{"7.", "هفت"},
+		{"۱۲٬۳۴۵٫۰۶", "دوازده هزار و سیصد و چهل و پنج ممیز صفر شش"},
+		{"12345678901234567.89", "دوازده کوادریلیون و سیصد و چهل و پنج تریلیون و ششصد و هفتاد و هشت میلیارد و نهصد و یک میلیون و دویست و سی و چهار هزار و پانصد و شصت و هفت ممیز هشتاد و نه"},
+		{"0.12345678901234567890123", "صفر ممیز دوازده سکستیلیون و سیصد و چهل و پنج کوینتیلیون و ششصد و هفتاد و هشت کوادریلیون و نهصد و یک تریلیون و دویست و سی و چهار میلیارد و پانصد و شصت و هفت میلیون و هشتصد و نود هزار و صد و بیست و سه"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ConvertDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ConvertDecimal(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ConvertDecimal(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertDecimal_Invalid(t *testing.T) {
+	for _, input := range []string{"", ".", "-", "1e5", "NaN", "1.2.3", "12a"} {
+		if _, err := ConvertDecimal(input); err == nil {
+			t.Errorf("ConvertDecimal(%q) expected error, got nil", input)
+		}
+	}
+}
+
+func TestConvertString_ExactDecimal(t *testing.T) {
+	expected, _ := ConvertDecimal("12345678901234567.89")
+	result, err := ConvertString("12345678901234567.89")
+	if err != nil {
+		t.Fatalf("ConvertString unexpected error: %v", err)
+	}
+	if result != expected {
+		t.Errorf("ConvertString(%q) = %q, want %q", "12345678901234567.89", result, expected)
+	}
+}
+
+func TestConvertFloat_BeyondInt64(t *testing.T) {
+	result := ConvertFloat(1e20, 1)
+	expected := "صد کوینتیلیون ممیز صفر"
+	if result != expected {
+		t.Errorf("ConvertFloat(1e20, 1) = %q, want %q", result, expected)
+	}
+}
+
+func TestConvertBigFloat(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision int
+		expected  string
+	}{
+		{"0", 0, "صفر"},
+		{"1.05", 2, "یک ممیز صفر پنج"},
+		{"-12.5", 1, "منفی دوازده ممیز پنج"},
+		{"1e30", 1, "یک نونیلیون ممیز صفر"},
+		{"+Inf", 2, "بی‌نهایت"},
+		{"-Inf", 2, "منفی بی‌نهایت"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			f, _, err := big.ParseFloat(tt.input, 10, 200, big.ToNearestEven)
+			if err != nil {
+				t.Fatalf("big.ParseFloat(%q): %v", tt.input, err)
+			}
+			result := ConvertBigFloat(f, tt.precision)
+			if result != tt.expected {
+				t.Errorf("ConvertBigFloat(%s, %d) = %q, want %q", tt.input, tt.precision, result, tt.expected)
+			}
+		})
+	}
+
+	if result := ConvertBigFloat(nil, 2); result != "صفر" {
+		t.Errorf("ConvertBigFloat(nil, 2) = %q, want %q", result, "صفر")
+	}
+}
+
+func TestConvertRat(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision int
+		expected  string
+	}{
+		{"1/3", 2, "صفر ممیز سی و سه"},
+		{"2/3", 3, "صفر ممیز ششصد و شصت و هفت"},
+		{"21/20", 2, "یک ممیز صفر پنج"},
+		{"-7/2", 1, "منفی سه ممیز پنج"},
+		{"5", 0, "پنج"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, _ := new(big.Rat).SetString(tt.input)
+			result := ConvertRat(r, tt.precision)
+			if result != tt.expected {
+				t.Errorf("ConvertRat(%s, %d) = %q, want %q", tt.input, tt.precision, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertRatFraction(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0", "صفر"},
+		{"1/2", "یک دوم"},
+		{"1/3", "یک سوم"},
+		{"2/5", "دو پنجم"},
+		{"17/5", "سه و دو پنجم"},
+		{"6/4", "یک و یک دوم"},
+		{"-3/4", "منفی سه چهارم"},
+		{"7/100", "هفت صدم"},
+		{"10/2", "پنج"},
+		{"1/1000000", "یک میلیونم"},
+		{"3/1000000", "سه میلیونم"},
+		{"1/1000", "یک هزارم"},
+		{"1/1001", "یک هزار و یکم"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, _ := new(big.Rat).SetString(tt.input)
+			result := ConvertRatFraction(r)
+			if result != tt.expected {
+				t.Errorf("ConvertRatFraction(%s) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	localized := []struct {
+		c        *Converter
+		input    string
+		expected string
+	}{
+		{dari, "1/100", "یک صدم"},
+		{dari, "3/1000000", "سه ملیونم"},
+		{NewConverter(Options{OneThousand: true}), "1/1000", "یک هزارم"},
+		{NewConverter(Options{Colloquial: true}), "1/1000000", "یک میلیونم"},
+	}
+	for _, tt := range localized {
+		t.Run(tt.expected, func(t *testing.T) {
+			r, _ := new(big.Rat).SetString(tt.input)
+			if result := tt.c.ConvertRatFraction(r); result != tt.expected {
+				t.Errorf("ConvertRatFraction(%s) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package num2persian
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	persianDecimalMark   = '٫'
+	persianThousandsMark = '٬'
+)
+
+// latinDigits replaces Extended Arabic-Indic (Persian) and Arabic-Indic
+// digits with ASCII digits, and the Arabic decimal and thousands marks with
+// "." and ",".
+func latinDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '۰' && r <= '۹':
+			return '0' + (r - '۰')
+		case r >= '٠' && r <= '٩':
+			return '0' + (r - '٠')
+		case r == persianDecimalMark:
+			return '.'
+		case r == persianThousandsMark:
+			return ','
+		}
+		return r
+	}, s)
+}
+
+// normalizeNumber rewrites a numeric string into the plain ASCII form
+// understood by strconv: digits are converted to ASCII, a leading "+" is
+// dropped and underscores and thousands separators are removed once their
+// placement has been validated. On failure it returns the reason.
+func normalizeNumber(s string) (string, string) {
+	s = latinDigits(strings.TrimSpace(s))
+
+	if rest, ok := strings.CutPrefix(s, "+"); ok {
+		if strings.HasPrefix(rest, "+") || strings.HasPrefix(rest, "-") {
+			return "", "invalid sign"
+		}
+		s = rest
+	}
+	if !strings.ContainsAny(s, "_,") {
+		return s, ""
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+
+	if strings.Contains(s, "_") {
+		for i := 0; i < len(s); i++ {
+			if s[i] == '_' && (i == 0 || i == len(s)-1 || !isDigit(s[i-1]) || !isDigit(s[i+1])) {
+				return "", "underscore must separate digits"
+			}
+		}
+		s = strings.ReplaceAll(s, "_", "")
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	if strings.Contains(fracPart, ",") {
+		return "", "thousands separator in fractional part"
+	}
+	if strings.Contains(intPart, ",") {
+		groups := strings.Split(intPart, ",")
+		for i, g := range groups {
+			if g == "" || len(g) > 3 || (i > 0 && len(g) != 3) || !allDigits(g) {
+				return "", "invalid digit grouping"
+			}
+		}
+		intPart = strings.Join(groups, "")
+	}
+
+	if hasFrac {
+		return sign + intPart + "." + fracPart, ""
+	}
+	return sign + intPart, ""
+}
+
+// shiftExponent rewrites a normalized number in exponent notation, such as
+// "1.5e3" or "1e-5", as plain digits, "1500" or "0.00001", moving the decimal
+// point in the digit string so no digit is lost. It returns "" when s is not
+// in exponent notation, and a reason when the exponent is beyond
+// MaxParseDigits.
+func shiftExponent(s string) (string, string) {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	i := strings.IndexAny(s, "eE")
+	if i == -1 {
+		return "", ""
+	}
+	intPart, frac, _ := strings.Cut(s[:i], ".")
+	if (intPart == "" && frac == "") || !allDigits(intPart) || !allDigits(frac) {
+		return "", ""
+	}
+	exp := s[i+1:]
+	negativeExp := strings.HasPrefix(exp, "-")
+	if negativeExp || strings.HasPrefix(exp, "+") {
+		exp = exp[1:]
+	}
+	if exp == "" || !allDigits(exp) {
+		return "", ""
+	}
+	exp = strings.TrimLeft(exp, "0")
+	shift, err := strconv.Atoi(exp)
+	if exp == "" {
+		shift, err = 0, nil
+	}
+	if err != nil || shift > MaxParseDigits {
+		return "", "exponent out of range"
+	}
+
+	digits := intPart + frac
+	point := len(intPart)
+	if negativeExp {
+		point -= shift
+	} else {
+		point += shift
+	}
+	if point < 1 {
+		digits = strings.Repeat("0", 1-point) + digits
+		point = 1
+	}
+	if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+	if point == len(digits) {
+		return sign + digits, ""
+	}
+	return sign + digits[:point] + "." + digits[point:], ""
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package num2persian
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConvertString_LocalizedDigits(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"۱۲۳۴", "هزار و دویست و سی و چهار"},
+		{"١٢٣٤", "هزار و دویست و سی و چهار"},
+		{"۱۲٫۵", "دوازده ممیز پنج"},
+		{"-۵۰۰", "منفی پانصد"},
+		{"+42", "چهل و دو"},
+		{"+۴۲", "چهل و دو"},
+		{"1,500,000", "یک میلیون و پانصد هزار"},
+		{"۱٬۵۰۰٬۰۰۰", "یک میلیون و پانصد هزار"},
+		{"-1,000.5", "منفی هزار ممیز پنج"},
+		{"1_000_000", "یک میلیون"},
+		{"۱٬۰۰۰٬۰۰۰٬۰۰۰٬۰۰۰٬۰۰۰٬۰۰۰٬۰۰۰", "یک سکستیلیون"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ConvertString(tt.input)
+			if err != nil {
+				t.Fatalf("ConvertString(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ConvertString(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertString_InvalidGrouping(t *testing.T) {
+	tests := []struct {
+		input  string
+		reason string
+	}{
+		{"1,00", "invalid digit grouping"},
+		{"1000,000", "invalid digit grouping"},
+		{",100", "invalid digit grouping"},
+		{"1,,000", "invalid digit grouping"},
+		{"۱٬۰۰", "invalid digit grouping"},
+		{"1.000,5", "thousands separator in fractional part"},
+		{"_100", "underscore must separate digits"},
+		{"1__000", "underscore must separate digits"},
+		{"100_", "underscore must separate digits"},
+		{"+-5", "invalid sign"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ConvertString(tt.input)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ConvertString(%q) error = %v, want *ParseError", tt.input, err)
+			}
+			if perr.Reason != tt.reason {
+				t.Errorf("ConvertString(%q) reason = %q, want %q", tt.input, perr.Reason, tt.reason)
+			}
+		})
+	}
+}
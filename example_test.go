@@ -37,6 +37,88 @@ func ExampleConvertBigInt() {
 	// یک دسیلیون
 }
 
+func ExampleConvertBigInt_generatedScales() {
+	n := new(big.Int).Exp(big.NewInt(10), big.NewInt(36), nil)
+	fmt.Println(num2persian.ConvertBigInt(n))
+
+	stacked := num2persian.NewConverter(num2persian.Options{StackScales: true})
+	fmt.Println(stacked.ConvertBigInt(n))
+	// Output:
+	// یک آندسیلیون
+	// هزار دسیلیون
+}
+
+func ExampleOptions_scaleSystem() {
+	long := num2persian.NewConverter(num2persian.Options{ScaleSystem: num2persian.LongScale})
+	fmt.Println(long.Convert(1000000000))
+	fmt.Println(long.Convert(1000000000000))
+	fmt.Println(long.Convert(1000000000000000))
+	// Output:
+	// یک میلیارد
+	// یک بیلیون
+	// یک بیلیارد
+}
+
+func ExampleOptions_dari() {
+	dari := num2persian.NewConverter(num2persian.Options{Locale: num2persian.Dari})
+	fmt.Println(dari.Convert(250))
+	fmt.Println(dari.ToCurrency(1500000))
+	// Output:
+	// دو صد و پنجاه
+	// یک ملیون و پنج صد هزار افغانی
+}
+
+func ExampleOptions_tajik() {
+	tajik := num2persian.NewConverter(num2persian.Options{Locale: num2persian.Tajik})
+	fmt.Println(tajik.Convert(1200))
+	fmt.Println(tajik.ConvertOrdinal(3))
+	fmt.Println(tajik.ToCurrency(25))
+	// Output:
+	// як ҳазор ва ду сад
+	// сеюм
+	// бист ва панҷ сомонӣ
+}
+
+func ExampleOptions_colloquial() {
+	spoken := num2persian.NewConverter(num2persian.Options{Colloquial: true})
+	fmt.Println(spoken.Convert(500))
+	fmt.Println(spoken.Convert(120))
+	fmt.Println(spoken.ToToman(1500000))
+	// Output:
+	// پونصد
+	// صد و بیس
+	// یه میلیون و پونصد هزار تومان
+}
+
+func ExampleOptions_finglish() {
+	finglish := num2persian.NewConverter(num2persian.Options{Script: num2persian.Finglish})
+	fmt.Println(finglish.ToToman(1500000))
+	fmt.Println(finglish.ConvertOrdinal(3))
+
+	romanized := num2persian.NewConverter(num2persian.Options{Script: num2persian.Romanized})
+	fmt.Println(romanized.ToToman(1500000))
+	// Output:
+	// yek million o pansad hezar toman
+	// sevom
+	// yak mīliyūn va pānṣad hazār tūmān
+}
+
+func ExampleConvertUint64() {
+	fmt.Println(num2persian.ConvertUint64(10000000000000000000))
+	// Output:
+	// ده کوینتیلیون
+}
+
+func ExampleConvertNumber() {
+	fmt.Println(num2persian.ConvertNumber(int8(-5)))
+	fmt.Println(num2persian.ConvertNumber(uint64(10000000000000000000)))
+	fmt.Println(num2persian.ConvertNumber(float32(0.1)))
+	// Output:
+	// منفی پنج
+	// ده کوینتیلیون
+	// صفر ممیز یک
+}
+
 func ExampleConvertFloat() {
 	fmt.Println(num2persian.ConvertFloat(12.5, 1))
 	fmt.Println(num2persian.ConvertFloat(3.14, 2))
@@ -87,3 +169,135 @@ func ExampleToRial() {
 	// ده هزار ریال
 	// پانزده میلیون ریال
 }
+
+func ExampleAppendConvert() {
+	buf := make([]byte, 0, 512)
+	buf = num2persian.AppendConvert(buf, 1234)
+	buf = append(buf, '\n')
+	buf = num2persian.AppendToman(buf, 1500000)
+	fmt.Println(string(buf))
+	// Output:
+	// هزار و دویست و سی و چهار
+	// یک میلیون و پانصد هزار تومان
+}
+
+func ExampleParse() {
+	n, err := num2persian.Parse("یک میلیون و پانصد هزار")
+	fmt.Println(n, err)
+	// Output:
+	// 1500000 <nil>
+}
+
+func ExampleParseBigInt() {
+	n, err := num2persian.ParseBigInt("هزار دسیلیون")
+	fmt.Println(n, err)
+	// Output:
+	// 1000000000000000000000000000000000000 <nil>
+}
+
+func ExampleParseLenient() {
+	n, corrections, err := num2persian.ParseLenient("يكهزار و هیجده")
+	fmt.Println(n, err)
+	for _, c := range corrections {
+		fmt.Printf("%s: %s -> %s\n", c.Kind, c.From, c.To)
+	}
+	// Output:
+	// 1018 <nil>
+	// letter: يكهزار -> یکهزار
+	// spacing: یکهزار -> یک هزار
+	// spelling: هیجده -> هجده
+}
+
+func ExampleParseFinglish() {
+	fmt.Println(num2persian.ParseFinglish("do milion o sisad hezar"))
+	fmt.Println(num2persian.ParseFinglish("panjah toman"))
+	// Output:
+	// 2300000 <nil>
+	// 50 <nil>
+}
+
+func ExampleConvertString_persianDigits() {
+	result, _ := num2persian.ConvertString("۱٬۵۰۰٬۰۰۰")
+	fmt.Println(result)
+	// Output:
+	// یک میلیون و پانصد هزار
+}
+
+func ExampleFormatDigits() {
+	fmt.Println(num2persian.FormatDigits(1500000))
+	fmt.Println(num2persian.FormatFloat(1234.5, 1))
+	fmt.Println(num2persian.ToLatinDigits("۱٬۵۰۰٬۰۰۰"))
+	// Output:
+	// ۱٬۵۰۰٬۰۰۰
+	// ۱٬۲۳۴٫۵
+	// 1,500,000
+}
+
+func ExampleNumberFormat() {
+	f := num2persian.NumberFormat{Digits: num2persian.ArabicDigits, ThousandsSeparator: "٬"}
+	fmt.Println(f.Format(1234567))
+	// Output:
+	// ١٬٢٣٤٬٥٦٧
+}
+
+func ExampleConvertFloatWithStyle() {
+	fmt.Println(num2persian.ConvertFloatWithStyle(1.05, 2, num2persian.DecimalPoint))
+	fmt.Println(num2persian.ConvertFloatWithStyle(1.05, 2, num2persian.DecimalFraction))
+	// Output:
+	// یک ممیز صفر پنج
+	// یک و پنج صدم
+}
+
+func ExampleConvertDecimal() {
+	result, _ := num2persian.ConvertDecimal("1234567890.05")
+	fmt.Println(result)
+	// Output:
+	// یک میلیارد و دویست و سی و چهار میلیون و پانصد و شصت و هفت هزار و هشتصد و نود ممیز صفر پنج
+}
+
+func ExampleConvertRatFraction() {
+	fmt.Println(num2persian.ConvertRatFraction(big.NewRat(17, 5)))
+	fmt.Println(num2persian.ConvertRat(big.NewRat(17, 5), 1))
+	// Output:
+	// سه و دو پنجم
+	// سه ممیز چهار
+}
+
+func ExampleNewConverter() {
+	c := num2persian.NewConverter(num2persian.Options{
+		OneThousand:  true,
+		DecimalStyle: num2persian.DecimalFraction,
+	})
+	fmt.Println(c.Convert(1001000))
+	fmt.Println(c.ConvertFloat(2.5, 1))
+	// Output:
+	// یک میلیون و یک هزار
+	// دو و پنج دهم
+}
+
+func ExampleToRialCheque() {
+	fmt.Println(num2persian.ToRialCheque(1001000))
+	// Output:
+	// فقط یک میلیون و یک هزار ریال تمام
+}
+
+func ExampleVerifyAmount() {
+	report, _ := num2persian.VerifyAmount("فقط یک میلیون و چهارصد هزار ریال تمام", big.NewInt(1500000))
+	fmt.Println(report.Match)
+	fmt.Println(report.Expected)
+	fmt.Println(report.Diff.Scale, report.Diff.Expected, report.Diff.Parsed)
+	// Output:
+	// false
+	// یک میلیون و پانصد هزار ریال
+	// 1 500 400
+}
+
+func ExampleConvertOrdinalAdjective() {
+	fmt.Println(num2persian.ConvertOrdinalAdjective(1))
+	fmt.Println(num2persian.ConvertOrdinalAdjective(2))
+	fmt.Println(num2persian.ConvertOrdinalAdjective(30))
+	// Output:
+	// اولین
+	// دومین
+	// سی‌امین
+}
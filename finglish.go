@@ -0,0 +1,221 @@
+package num2persian
+
+import (
+	"math/big"
+	"strings"
+)
+
+// finglishConverter reads and writes Persian in casual Latin letters.
+var finglishConverter = NewConverter(Options{Script: Finglish})
+
+// finglishSpellings lists the common Latin spellings of each Finglish word
+// beside the one the Finglish vocabulary uses.
+var finglishSpellings = map[string][]string{
+	"sefr":      {"sefer", "sifr"},
+	"manfi":     {"manfee", "menha"},
+	"o":         {"va", "v", "ve", "wa", "u", "&"},
+	"momayez":   {"momayyez", "mommayez", "momaiez"},
+	"yek":       {"yak", "yeck", "yeek"},
+	"do":        {"doo", "dow"},
+	"se":        {"seh", "sey"},
+	"chahar":    {"chahaar", "chehar", "char", "chaar"},
+	"panj":      {"pandj"},
+	"shesh":     {"shish", "sheesh"},
+	"noh":       {"nuh", "nooh", "nouh"},
+	"dah":       {"da", "deh"},
+	"yazdah":    {"yazda", "yazdeh"},
+	"davazdah":  {"davazda", "davazdeh", "davaazdah"},
+	"sizdah":    {"sizda", "sizdeh", "seezdah"},
+	"chahardah": {"chahardeh", "chahaarda", "chardah", "charda"},
+	"panzdah":   {"panzda", "panzdeh", "poonzdah", "poonzda", "punzdah", "poonze"},
+	"shanzdah":  {"shanzda", "shanzdeh", "shoonzdah", "shoonzda", "shunzdah", "shoonze"},
+	"hefdah":    {"hefda", "hefdeh", "hifdah", "hivdah", "hivda", "hevdah"},
+	"hejdah":    {"hejda", "hejdeh", "hijdah", "hijda", "hezhdah", "hizhdah"},
+	"noozdah":   {"noozda", "noozdeh", "nuzdah", "nuzda", "nozdah"},
+	"bist":      {"beest", "bis"},
+	"si":        {"see"},
+	"chehel":    {"chehl", "chel", "chihil"},
+	"panjah":    {"panja", "panjaah"},
+	"shast":     {"shasht", "shas"},
+	"haftad":    {"haftaad", "haftat"},
+	"hashtad":   {"hashtaad", "hashtat"},
+	"navad":     {"navaad", "navat", "nawad"},
+	"sad":       {"saad"},
+	"devist":    {"divist", "dvist", "devis", "deveest"},
+	"sisad":     {"sesad", "seesad", "sisat", "sesat"},
+	"chaharsad": {"charsad", "chaarsad", "chaharsat"},
+	"pansad":    {"poonsad", "punsad", "pansat", "paansad"},
+	"sheshsad":  {"shishsad", "sheshsat", "shishsat"},
+	"haftsad":   {"haftsat"},
+	"hashtsad":  {"hashtsat"},
+	"nohsad":    {"nosad", "nuhsad", "nohsat"},
+	"hezar":     {"hizar", "hazar", "hezaar", "hezzar"},
+	"million":   {"milion", "milyon", "miliyon", "melyon", "milioon", "meliun"},
+	"milliard":  {"miliard", "milyard", "miliyard", "melyard", "milyarad"},
+}
+
+// finglishUnits maps the Latin spellings of the currency units to the unit.
+var finglishUnits = map[string]Unit{
+	"toman":  Toman,
+	"tomen":  Toman,
+	"tooman": Toman,
+	"tuman":  Toman,
+	"tomān":  Toman,
+	"tūmān":  Toman,
+	"rial":   Rial,
+	"riyal":  Rial,
+	"reyal":  Rial,
+	"rīyāl":  Rial,
+}
+
+// finglishWords maps every accepted Latin spelling to the word of the
+// Finglish vocabulary. Besides finglishSpellings it holds the romanized
+// vocabulary, with and without diacritics, and single-l spellings of the
+// scale names: "dū" and "du" read as "do", "trilion" as "trillion".
+var finglishWords = func() map[string]string {
+	m := make(map[string]string)
+	for word, spellings := range finglishSpellings {
+		for _, s := range spellings {
+			m[s] = word
+		}
+	}
+
+	f, r := finglishVocabulary, romanizedVocabulary
+	pairs := [][2][]string{
+		{f.ones, r.ones},
+		{f.teens, r.teens},
+		{f.tens, r.tens},
+		{f.hundreds, r.hundreds},
+		{f.scales, r.scales},
+		{
+			{f.zero, f.negative, f.decimalPoint, strings.TrimSpace(f.separator)},
+			{r.zero, r.negative, r.decimalPoint, strings.TrimSpace(r.separator)},
+		},
+	}
+	for _, p := range pairs {
+		for i, word := range p[0] {
+			if word == "" {
+				continue
+			}
+			for _, s := range []string{p[1][i], stripDiacritics(p[1][i])} {
+				if s != word {
+					m[s] = word
+				}
+			}
+		}
+	}
+	for _, word := range f.scales {
+		for _, s := range []string{
+			strings.Replace(word, "illi", "ili", 1),
+			strings.Replace(word, "illion", "ilion", 1),
+			strings.Replace(word, "illion", "ilyon", 1),
+		} {
+			if s != word {
+				m[s] = word
+			}
+		}
+	}
+	return m
+}()
+
+// diacriticReplacer spells romanized letters in plain ASCII.
+var diacriticReplacer = strings.NewReplacer(
+	"ā", "a", "ī", "i", "ū", "u", "ṣ", "s", "ʻ", "", "ʼ", "", "'", "",
+)
+
+func stripDiacritics(s string) string {
+	return diacriticReplacer.Replace(s)
+}
+
+// ParseFinglish converts Persian number words written in Latin letters, such
+// as "do milion o sisad hezar", to an int64. Common spellings of every word
+// are accepted, as are the romanized forms, the connector glued to the word
+// before it ("bisto panj") and a trailing "toman" or "rial".
+func ParseFinglish(s string) (int64, error) {
+	n, err := parseFinglish(s, int64Limit)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsInt64() {
+		return 0, &ParseError{Input: s, Reason: "value out of int64 range"}
+	}
+	return n.Int64(), nil
+}
+
+// ParseFinglishBigInt converts Persian number words written in Latin letters
+// to a big.Int of up to MaxParseDigits digits. It accepts the same spellings
+// as ParseFinglish.
+func ParseFinglishBigInt(s string) (*big.Int, error) {
+	return parseFinglish(s, bigIntLimit)
+}
+
+func parseFinglish(s string, limit parseLimit) (*big.Int, error) {
+	words := normalizeFinglish(s)
+	if n := len(words); n > 0 {
+		if _, ok := finglishUnits[words[n-1]]; ok {
+			words = words[:n-1]
+		}
+	}
+
+	n, err := finglishConverter.parseWords(strings.Join(words, " "), limit)
+	if err != nil {
+		if perr, ok := err.(*ParseError); ok {
+			perr.Input = s
+		}
+		return nil, err
+	}
+	return n, nil
+}
+
+// finglishReplacer splits words at hyphens and reads commas as the connector.
+var finglishReplacer = strings.NewReplacer(
+	"-", " ",
+	",", " o ",
+	"،", " o ",
+)
+
+// normalizeFinglish rewrites Latin number words into the Finglish
+// vocabulary, returning the resulting words.
+func normalizeFinglish(s string) []string {
+	c := finglishConverter
+	connector := c.connector()
+	var words []string
+	for _, w := range strings.Fields(finglishReplacer.Replace(strings.ToLower(s))) {
+		w = finglishWord(w)
+		if !c.isNumberWord(w) && w != connector {
+			// "bisto" and "sado" glue the connector to the word before it.
+			if stem, found := strings.CutSuffix(w, connector); found {
+				if stem = finglishWord(stem); c.isNumberWord(stem) {
+					words = append(words, stem, connector)
+					continue
+				}
+			}
+		}
+
+		n := len(words)
+		if w == c.hundreds[1] && n > 0 {
+			// "se sad" and "yek sad" name a single hundreds word.
+			if info, ok := c.words[words[n-1]]; ok && info.kind == kindOnes {
+				words[n-1] = c.hundreds[info.value]
+				continue
+			}
+		}
+		words = append(words, w)
+	}
+	return words
+}
+
+// finglishWord returns the Finglish vocabulary word spelled w, or w itself.
+func finglishWord(w string) string {
+	if v, ok := finglishWords[w]; ok {
+		return v
+	}
+	return w
+}
+
+// isNumberWord reports whether w is a number or scale word of the
+// Converter's vocabulary.
+func (c *Converter) isNumberWord(w string) bool {
+	_, ok := c.lookup(w)
+	return ok
+}
@@ -0,0 +1,110 @@
+package num2persian
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestParseFinglish(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"sefr", 0},
+		{"yek", 1},
+		{"hejdah", 18},
+		{"hijdah", 18},
+		{"shoonzdah", 16},
+		{"bist o panj", 25},
+		{"bisto panj", 25},
+		{"bist-o-panj", 25},
+		{"bist va panj", 25},
+		{"sisad", 300},
+		{"sesad", 300},
+		{"se sad", 300},
+		{"yek sad o bist", 120},
+		{"hezar", 1000},
+		{"hizar", 1000},
+		{"yek hezar", 1000},
+		{"panjah toman", 50},
+		{"Panjah Toman", 50},
+		{"do milion o sisad hezar", 2300000},
+		{"yek million o pansad hezar toman", 1500000},
+		{"do miliard, pansad hezar rial", 2000500000},
+		{"se trilion", 3000000000000},
+		{"manfi haftado hasht", -78},
+		{"yak mīliyūn va pānṣad hazār tūmān", 1500000},
+		{"du hazar u sih", 2003},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseFinglish(tt.input)
+			if err != nil {
+				t.Fatalf("ParseFinglish(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseFinglish(%q) = %d, want %d", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFinglish_Errors(t *testing.T) {
+	tests := []string{
+		"",
+		"toman",
+		"bist panj",
+		"panj bist",
+		"hezar hezar",
+		"bist o",
+		"yek sefr",
+		"seven",
+		"do million o do million",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseFinglish(input)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseFinglish(%q) error = %v, want *ParseError", input, err)
+			}
+			if perr.Input != input {
+				t.Errorf("ParseError.Input = %q, want %q", perr.Input, input)
+			}
+		})
+	}
+}
+
+func TestParseFinglish_RoundTrip(t *testing.T) {
+	for n := int64(-1000); n <= 100000; n += 7 {
+		for _, c := range []*Converter{finglish, romanized} {
+			text := c.Convert(n)
+			result, err := ParseFinglish(text)
+			if err != nil {
+				t.Fatalf("ParseFinglish(%q) unexpected error: %v", text, err)
+			}
+			if result != n {
+				t.Fatalf("ParseFinglish(%q) = %d, want %d", text, result, n)
+			}
+		}
+	}
+}
+
+func TestParseFinglishBigInt(t *testing.T) {
+	n, _ := new(big.Int).SetString("123456789012345678901234567890123456789012", 10)
+	text := finglish.ConvertBigInt(n)
+	result, err := ParseFinglishBigInt(text)
+	if err != nil {
+		t.Fatalf("ParseFinglishBigInt(%q) unexpected error: %v", text, err)
+	}
+	if result.Cmp(n) != 0 {
+		t.Errorf("ParseFinglishBigInt(%q) = %s, want %s", text, result, n)
+	}
+
+	if _, err := ParseFinglish("yek undecillion"); err == nil {
+		t.Error("ParseFinglish(\"yek undecillion\") expected out of range error")
+	}
+}
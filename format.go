@@ -0,0 +1,134 @@
+package num2persian
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DigitSet selects the digits used when formatting numbers.
+type DigitSet int
+
+const (
+	PersianDigits DigitSet = iota // ۰۱۲۳۴۵۶۷۸۹
+	ArabicDigits                  // ٠١٢٣٤٥٦٧٨٩
+	LatinDigits                   // 0123456789
+)
+
+func (d DigitSet) zero() rune {
+	switch d {
+	case ArabicDigits:
+		return '٠'
+	case LatinDigits:
+		return '0'
+	default:
+		return '۰'
+	}
+}
+
+// NumberFormat describes how numbers are written in digits.
+// An empty ThousandsSeparator disables grouping. An empty DecimalMark
+// selects "٫" for Persian and Arabic-Indic digits and "." for Latin digits.
+type NumberFormat struct {
+	Digits             DigitSet
+	ThousandsSeparator string
+	DecimalMark        string
+}
+
+var defaultNumberFormat = NumberFormat{
+	Digits:             PersianDigits,
+	ThousandsSeparator: string(persianThousandsMark),
+	DecimalMark:        string(persianDecimalMark),
+}
+
+// FormatDigits formats an integer with Persian digits and separators.
+func FormatDigits(n int64) string {
+	return defaultNumberFormat.Format(n)
+}
+
+// FormatBigInt formats a big.Int with Persian digits and separators.
+func FormatBigInt(n *big.Int) string {
+	return defaultNumberFormat.FormatBigInt(n)
+}
+
+// FormatFloat formats a float64 with Persian digits and separators using the
+// specified decimal precision.
+func FormatFloat(n float64, precision int) string {
+	return defaultNumberFormat.FormatFloat(n, precision)
+}
+
+// ToLatinDigits replaces Persian and Arabic-Indic digits with ASCII digits
+// and the "٫" and "٬" marks with "." and ",", as ConvertString does before
+// parsing. Other characters are left untouched.
+func ToLatinDigits(s string) string {
+	return latinDigits(s)
+}
+
+// Format formats an integer.
+func (f NumberFormat) Format(n int64) string {
+	return f.localize(strconv.FormatInt(n, 10))
+}
+
+// FormatBigInt formats a big.Int. A nil value is formatted as zero.
+func (f NumberFormat) FormatBigInt(n *big.Int) string {
+	if n == nil {
+		return f.localize("0")
+	}
+	return f.localize(n.String())
+}
+
+// FormatFloat formats a float64 with the specified decimal precision.
+// NaN and infinities are returned as formatted by strconv.
+func (f NumberFormat) FormatFloat(n float64, precision int) string {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	if precision < 0 {
+		precision = 0
+	}
+	return f.localize(strconv.FormatFloat(n, 'f', precision, 64))
+}
+
+// localize rewrites an ASCII decimal such as "-1234.5" using the format.
+func (f NumberFormat) localize(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i := 0; i < len(intPart); i++ {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(f.ThousandsSeparator)
+		}
+		f.writeDigit(&b, intPart[i])
+	}
+	if hasFrac {
+		b.WriteString(f.decimalMark())
+		for i := 0; i < len(fracPart); i++ {
+			f.writeDigit(&b, fracPart[i])
+		}
+	}
+	return b.String()
+}
+
+func (f NumberFormat) writeDigit(b *strings.Builder, c byte) {
+	if isDigit(c) {
+		b.WriteRune(f.Digits.zero() + rune(c-'0'))
+	} else {
+		b.WriteByte(c)
+	}
+}
+
+func (f NumberFormat) decimalMark() string {
+	if f.DecimalMark != "" {
+		return f.DecimalMark
+	}
+	if f.Digits == LatinDigits {
+		return "."
+	}
+	return string(persianDecimalMark)
+}
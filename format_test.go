@@ -0,0 +1,121 @@
+package num2persian
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFormatDigits(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "۰"},
+		{7, "۷"},
+		{999, "۹۹۹"},
+		{1000, "۱٬۰۰۰"},
+		{1500000, "۱٬۵۰۰٬۰۰۰"},
+		{-1234, "-۱٬۲۳۴"},
+		{math.MinInt64, "-۹٬۲۲۳٬۳۷۲٬۰۳۶٬۸۵۴٬۷۷۵٬۸۰۸"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := FormatDigits(tt.input)
+			if result != tt.expected {
+				t.Errorf("FormatDigits(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatBigInt(t *testing.T) {
+	n, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	expected := "۱٬۰۰۰٬۰۰۰٬۰۰۰٬۰۰۰٬۰۰۰٬۰۰۰٬۰۰۰"
+	if result := FormatBigInt(n); result != expected {
+		t.Errorf("FormatBigInt(%s) = %q, want %q", n, result, expected)
+	}
+	if result := FormatBigInt(nil); result != "۰" {
+		t.Errorf("FormatBigInt(nil) = %q, want %q", result, "۰")
+	}
+}
+
+func TestFormatFloat(t *testing.T) {
+	tests := []struct {
+		input     float64
+		precision int
+		expected  string
+	}{
+		{12.5, 1, "۱۲٫۵"},
+		{1234.5678, 2, "۱٬۲۳۴٫۵۷"},
+		{-0.25, 2, "-۰٫۲۵"},
+		{3, 0, "۳"},
+		{math.NaN(), 2, "NaN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := FormatFloat(tt.input, tt.precision)
+			if result != tt.expected {
+				t.Errorf("FormatFloat(%v, %d) = %q, want %q", tt.input, tt.precision, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   NumberFormat
+		expected string
+	}{
+		{"Persian", NumberFormat{Digits: PersianDigits, ThousandsSeparator: "٬"}, "۱٬۲۳۴٬۵۶۷٫۸۹"},
+		{"Arabic", NumberFormat{Digits: ArabicDigits, ThousandsSeparator: "٬"}, "١٬٢٣٤٬٥٦٧٫٨٩"},
+		{"Latin", NumberFormat{Digits: LatinDigits, ThousandsSeparator: ","}, "1,234,567.89"},
+		{"NoGrouping", NumberFormat{Digits: LatinDigits}, "1234567.89"},
+		{"CustomMarks", NumberFormat{Digits: PersianDigits, ThousandsSeparator: " ", DecimalMark: "/"}, "۱ ۲۳۴ ۵۶۷/۸۹"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.format.FormatFloat(1234567.89, 2)
+			if result != tt.expected {
+				t.Errorf("FormatFloat(1234567.89, 2) = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToLatinDigits(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"۱۲۳۴۵۶۷۸۹۰", "1234567890"},
+		{"١٢٣٤٥٦٧٨٩٠", "1234567890"},
+		{"۱٬۵۰۰٫۲۵", "1,500.25"},
+		{"قیمت: ۱۲ تومان", "قیمت: 12 تومان"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := ToLatinDigits(tt.input)
+			if result != tt.expected {
+				t.Errorf("ToLatinDigits(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatDigits_RoundTrip(t *testing.T) {
+	for _, n := range []int64{0, 12, 1234, -987654321, math.MaxInt64} {
+		result, err := ConvertString(FormatDigits(n))
+		if err != nil {
+			t.Fatalf("ConvertString(FormatDigits(%d)) unexpected error: %v", n, err)
+		}
+		if expected := Convert(n); result != expected {
+			t.Errorf("ConvertString(FormatDigits(%d)) = %q, want %q", n, result, expected)
+		}
+	}
+}
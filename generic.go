@@ -0,0 +1,81 @@
+package num2persian
+
+import (
+	"math"
+	"strconv"
+)
+
+// Integer is the set of integer types accepted by the generic functions.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is the set of floating-point types accepted by the generic functions.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is the set of integer and floating-point types accepted by
+// ConvertNumber.
+type Number interface {
+	Integer | Float
+}
+
+// ConvertNumber converts a number of any integer or floating-point type to
+// Persian text. Unsigned values are converted without going through int64,
+// so the whole uint64 range is supported. Floats are written with the
+// fewest digits that represent the value in their own precision, so
+// float32(0.1) becomes "صفر ممیز یک".
+func ConvertNumber[T Number](n T) string {
+	return convertNumber(defaultConverter, n)
+}
+
+// ToTomanNumber converts a number of any integer or floating-point type to
+// Persian text with "تومان" suffix.
+func ToTomanNumber[T Number](n T) string {
+	return convertNumber(defaultConverter, n) + " " + tomanUnit
+}
+
+// ToRialNumber converts a number of any integer or floating-point type to
+// Persian text with "ریال" suffix.
+func ToRialNumber[T Number](n T) string {
+	return convertNumber(defaultConverter, n) + " " + rialUnit
+}
+
+// OrdinalNumber converts an integer of any type to Persian ordinal text.
+// It returns an empty string for n <= 0.
+func OrdinalNumber[T Integer](n T) string {
+	if n <= 0 {
+		return ""
+	}
+	return defaultConverter.ConvertOrdinalUint64(uint64(n))
+}
+
+func convertNumber[T Number](c *Converter, n T) string {
+	if isFloat[T]() {
+		f := float64(n)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return c.convertFloat(f, 0, c.decimalStyle)
+		}
+		text, _ := c.decimalText(strconv.FormatFloat(f, 'f', -1, floatBits[T]()), c.decimalStyle)
+		return text
+	}
+	if n < 0 {
+		return c.Convert(int64(n))
+	}
+	return c.ConvertUint64(uint64(n))
+}
+
+// isFloat reports whether T is a floating-point type.
+func isFloat[T Number]() bool {
+	return T(1)/2 != 0
+}
+
+// floatBits returns the precision of the floating-point type T in bits.
+func floatBits[T Number]() int {
+	if third := T(1) / 3; float64(third) != 1.0/3 {
+		return 32
+	}
+	return 64
+}
@@ -0,0 +1,82 @@
+package num2persian
+
+import (
+	"math"
+	"testing"
+)
+
+type myInt int16
+
+func TestConvertNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"int8", ConvertNumber(int8(-128)), "منفی صد و بیست و هشت"},
+		{"int16", ConvertNumber(int16(1234)), "هزار و دویست و سی و چهار"},
+		{"int32", ConvertNumber(int32(math.MinInt32)), Convert(math.MinInt32)},
+		{"int64", ConvertNumber(int64(math.MinInt64)), Convert(math.MinInt64)},
+		{"int", ConvertNumber(0), "صفر"},
+		{"uint8", ConvertNumber(uint8(255)), "دویست و پنجاه و پنج"},
+		{"uint16", ConvertNumber(uint16(65535)), "شصت و پنج هزار و پانصد و سی و پنج"},
+		{"uint32", ConvertNumber(uint32(math.MaxUint32)), Convert(math.MaxUint32)},
+		{"uint64", ConvertNumber(uint64(math.MaxUint64)), ConvertUint64(math.MaxUint64)},
+		{"uintptr", ConvertNumber(uintptr(7)), "هفت"},
+		{"named", ConvertNumber(myInt(-21)), "منفی بیست و یک"},
+		{"float32", ConvertNumber(float32(0.1)), "صفر ممیز یک"},
+		{"float64", ConvertNumber(0.1), "صفر ممیز یک"},
+		{"float64 integer", ConvertNumber(42.0), "چهل و دو"},
+		{"float64 negative", ConvertNumber(-12.5), "منفی دوازده ممیز پنج"},
+		{"float32 negative zero", ConvertNumber(float32(math.Copysign(0, -1))), "صفر"},
+		{"NaN", ConvertNumber(math.NaN()), "نامعین"},
+		{"-Inf", ConvertNumber(float32(math.Inf(-1))), "منفی بی‌نهایت"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("ConvertNumber = %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToTomanNumber(t *testing.T) {
+	result := ToTomanNumber(uint32(1500000))
+	expected := "یک میلیون و پانصد هزار تومان"
+	if result != expected {
+		t.Errorf("ToTomanNumber(1500000) = %q, want %q", result, expected)
+	}
+}
+
+func TestToRialNumber(t *testing.T) {
+	result := ToRialNumber(int8(-5))
+	expected := "منفی پنج ریال"
+	if result != expected {
+		t.Errorf("ToRialNumber(-5) = %q, want %q", result, expected)
+	}
+}
+
+func TestOrdinalNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   string
+		expected string
+	}{
+		{"int8", OrdinalNumber(int8(3)), "سوم"},
+		{"int8 negative", OrdinalNumber(int8(-3)), ""},
+		{"int zero", OrdinalNumber(0), ""},
+		{"uint16", OrdinalNumber(uint16(1)), "اول"},
+		{"uint64", OrdinalNumber(uint64(math.MaxUint64)), ConvertOrdinalUint64(math.MaxUint64)},
+		{"named", OrdinalNumber(myInt(30)), "سی‌ام"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("OrdinalNumber = %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package num2persian
+
+// Locale selects the variety of Persian a Converter writes.
+type Locale int
+
+const (
+	// Persian is the Persian (Farsi) of Iran.
+	Persian Locale = iota
+	// Dari is the Persian of Afghanistan: "یک صد"، "دو صد"، "هژده"، "نزده"،
+	// "ملیون"، "یک هزار" and "اعشاریه" for the decimal point.
+	Dari
+	// Tajik is the Persian of Tajikistan in Cyrillic script: "як ҳазор ва
+	// ду сад", with ordinals in "-ум" or "-юм" (сеюм) and сомонӣ/дирам as
+	// currency.
+	Tajik
+)
+
+// Script selects the writing system of a Converter's words.
+type Script int
+
+const (
+	// NativeScript writes the words in the script of the locale.
+	NativeScript Script = iota
+	// Finglish writes Persian in casual Latin transliteration, as typed in
+	// chats and SMS: "yek million o pansad hezar toman".
+	Finglish
+	// Romanized writes Persian in a formal romanization following ALA-LC:
+	// "yak mīliyūn va pānṣad hazār tūmān".
+	Romanized
+)
+
+// vocabulary is the word set of a locale.
+type vocabulary struct {
+	zero, negative, separator, decimalPoint, notANumber, infinity string
+
+	ones, teens, tens, hundreds []string
+	// finalTens, when set, replaces tens at the end of a group, where spoken
+	// Persian drops the final consonant: "صد و بیس" but "بیست و یک".
+	finalTens []string
+	// scaleOne is the word for one before a scale when it differs from
+	// ones[1], as "یه" does in spoken Persian.
+	scaleOne string
+	// scales is the scales table in IranianScale; names extends it.
+	scales []string
+	names  *scaleNamer
+
+	// oneThousand writes "یک" before the thousand scale.
+	oneThousand bool
+	// firstOrdinals holds the ordinal words for one, indexed by FirstOrdinal.
+	firstOrdinals []string
+	ordinals      *ordinalRules
+	// joiner joins the words of a compound such as ده‌هزارم.
+	joiner string
+	// currency and subunit are the units of ToCurrency and ToSubunit; toman
+	// and rial are the words for the Iranian units.
+	currency, subunit string
+	toman, rial       string
+	// chequePrefix and chequeSuffix wrap the amount in cheque wording;
+	// empty selects the Persian "فقط ... تمام".
+	chequePrefix, chequeSuffix string
+}
+
+var persianVocabulary = &vocabulary{
+	zero:          zero,
+	negative:      negative,
+	separator:     separator,
+	decimalPoint:  decimalPoint,
+	notANumber:    notANumber,
+	infinity:      infinity,
+	ones:          ones,
+	teens:         teens,
+	tens:          tens,
+	hundreds:      hundreds,
+	scales:        scales,
+	names:         persianScaleNamer,
+	firstOrdinals: firstOrdinals,
+	ordinals:      persianOrdinals,
+	joiner:        zwnj,
+	currency:      tomanUnit,
+	subunit:       rialUnit,
+	toman:         tomanUnit,
+	rial:          rialUnit,
+}
+
+// colloquialVocabulary is Persian as spoken in Tehran. Only the words of
+// the number differ from persianVocabulary.
+var colloquialVocabulary = func() *vocabulary {
+	v := *persianVocabulary
+	v.ones = []string{"", "یک", "دو", "سه", "چار", "پنج", "شیش", "هفت", "هشت", "نه"}
+	v.teens = []string{"ده", "یازده", "دوازده", "سیزده", "چارده", "پونزده",
+		"شونزده", "هیفده", "هیجده", "نوزده"}
+	v.finalTens = []string{"", "", "بیس", "سی", "چهل", "پنجا", "شصت", "هفتاد", "هشتاد", "نود"}
+	v.hundreds = []string{"", "صد", "دویست", "سیصد", "چارصد", "پونصد",
+		"شیشصد", "هفصد", "هشصد", "نهصد"}
+	v.scaleOne = "یه"
+	v.ordinals = colloquialOrdinals
+	return &v
+}()
+
+var dariVocabulary = &vocabulary{
+	zero:         zero,
+	negative:     negative,
+	separator:    separator,
+	decimalPoint: "اعشاریه",
+	notANumber:   notANumber,
+	infinity:     infinity,
+	ones:         ones,
+	teens: []string{"ده", "یازده", "دوازده", "سیزده", "چهارده", "پانزده",
+		"شانزده", "هفده", "هژده", "نزده"},
+	tens: tens,
+	hundreds: []string{"", "یک صد", "دو صد", "سه صد", "چهار صد", "پنج صد",
+		"شش صد", "هفت صد", "هشت صد", "نه صد"},
+	scales:        dariScaleNamer.table("هزار", len(scales), IranianScale),
+	names:         dariScaleNamer,
+	oneThousand:   true,
+	firstOrdinals: firstOrdinals,
+	ordinals:      persianOrdinals,
+	joiner:        zwnj,
+	currency:      "افغانی",
+	subunit:       "پول",
+	toman:         tomanUnit,
+	rial:          rialUnit,
+}
+
+var tajikVocabulary = &vocabulary{
+	zero:         "сифр",
+	negative:     "минус",
+	separator:    " ва ",
+	decimalPoint: "бутун",
+	notANumber:   "номуайян",
+	infinity:     "беохир",
+	ones:         []string{"", "як", "ду", "се", "чор", "панҷ", "шаш", "ҳафт", "ҳашт", "нӯҳ"},
+	teens: []string{"даҳ", "ёздаҳ", "дувоздаҳ", "сенздаҳ", "чордаҳ", "понздаҳ",
+		"шонздаҳ", "ҳабдаҳ", "ҳаждаҳ", "нуздаҳ"},
+	tens: []string{"", "", "бист", "сӣ", "чил", "панҷоҳ", "шаст", "ҳафтод", "ҳаштод", "навад"},
+	hundreds: []string{"", "як сад", "ду сад", "се сад", "чор сад", "панҷ сад",
+		"шаш сад", "ҳафт сад", "ҳашт сад", "нӯҳ сад"},
+	scales:        tajikScaleNamer.table("ҳазор", len(scales), IranianScale),
+	names:         tajikScaleNamer,
+	oneThousand:   true,
+	firstOrdinals: []string{"аввал", "якум", "нахуст"},
+	ordinals:      tajikOrdinals,
+	currency:      "сомонӣ",
+	subunit:       "дирам",
+	toman:         "томан",
+	rial:          "риёл",
+	chequePrefix:  "фақат",
+	chequeSuffix:  "тамом",
+}
+
+var finglishVocabulary = &vocabulary{
+	zero:         "sefr",
+	negative:     "manfi",
+	separator:    " o ",
+	decimalPoint: "momayez",
+	notANumber:   "namoayan",
+	infinity:     "binahayat",
+	ones:         []string{"", "yek", "do", "se", "chahar", "panj", "shesh", "haft", "hasht", "noh"},
+	teens: []string{"dah", "yazdah", "davazdah", "sizdah", "chahardah", "panzdah",
+		"shanzdah", "hefdah", "hejdah", "noozdah"},
+	tens: []string{"", "", "bist", "si", "chehel", "panjah", "shast", "haftad", "hashtad", "navad"},
+	hundreds: []string{"", "sad", "devist", "sisad", "chaharsad", "pansad",
+		"sheshsad", "haftsad", "hashtsad", "nohsad"},
+	scales:        latinScaleNamer.table("hezar", len(scales), IranianScale),
+	names:         latinScaleNamer,
+	firstOrdinals: []string{"avval", "yekom", "nokhost"},
+	ordinals:      finglishOrdinals,
+	joiner:        "-",
+	currency:      "toman",
+	subunit:       "rial",
+	toman:         "toman",
+	rial:          "rial",
+	chequePrefix:  "faghat",
+	chequeSuffix:  "tamam",
+}
+
+var romanizedVocabulary = &vocabulary{
+	zero:         "ṣifr",
+	negative:     "manfī",
+	separator:    " va ",
+	decimalPoint: "mumayyiz",
+	notANumber:   "nāmuʻayyan",
+	infinity:     "bīnihāyat",
+	ones:         []string{"", "yak", "dū", "sih", "chahār", "panj", "shish", "haft", "hasht", "nuh"},
+	teens: []string{"dah", "yāzdah", "davāzdah", "sīzdah", "chahārdah", "pānzdah",
+		"shānzdah", "hifdah", "hijdah", "nūzdah"},
+	tens: []string{"", "", "bīst", "sī", "chihil", "panjāh", "shaṣt", "haftād", "hashtād", "navad"},
+	hundreds: []string{"", "ṣad", "divīst", "sīṣad", "chahārṣad", "pānṣad",
+		"shishṣad", "haftṣad", "hashtṣad", "nuhṣad"},
+	scales:        romanizedScaleNamer.table("hazār", len(scales), IranianScale),
+	names:         romanizedScaleNamer,
+	firstOrdinals: []string{"avval", "yakum", "nukhust"},
+	ordinals:      romanizedOrdinals,
+	joiner:        "-",
+	currency:      "tūmān",
+	subunit:       "rīyāl",
+	toman:         "tūmān",
+	rial:          "rīyāl",
+	chequePrefix:  "faqaṭ",
+	chequeSuffix:  "tamām",
+}
+
+// transliterations holds the Persian vocabulary in each Latin script.
+var transliterations = []*vocabulary{
+	Finglish:  finglishVocabulary,
+	Romanized: romanizedVocabulary,
+}
+
+var vocabularies = []*vocabulary{
+	Persian: persianVocabulary,
+	Dari:    dariVocabulary,
+	Tajik:   tajikVocabulary,
+}
@@ -0,0 +1,421 @@
+package num2persian
+
+import (
+	"math/big"
+	"testing"
+)
+
+var dari = NewConverter(Options{Locale: Dari})
+
+func TestDari_Convert(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "صفر"},
+		{18, "هژده"},
+		{19, "نزده"},
+		{100, "یک صد"},
+		{250, "دو صد و پنجاه"},
+		{500, "پنج صد"},
+		{1000, "یک هزار"},
+		{1100, "یک هزار و یک صد"},
+		{1000000, "یک ملیون"},
+		{2500000000, "دو ملیارد و پنج صد ملیون"},
+		{1000000000000, "یک ترلیون"},
+		{-919, "منفی نه صد و نزده"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := dari.Convert(tt.input); result != tt.expected {
+				t.Errorf("Convert(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDari_LongScale(t *testing.T) {
+	c := NewConverter(Options{Locale: Dari, ScaleSystem: LongScale})
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{1000000000, "یک ملیارد"},
+		{1000000000000, "یک بلیون"},
+		{1000000000000000, "یک بلیارد"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := c.Convert(tt.input); result != tt.expected {
+				t.Errorf("Convert(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDari_Ordinal(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{1, "اول"},
+		{3, "سوم"},
+		{19, "نزدهم"},
+		{30, "سی‌ام"},
+		{100, "یک صدم"},
+		{1000, "یک هزارم"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := dari.ConvertOrdinal(tt.input); result != tt.expected {
+				t.Errorf("ConvertOrdinal(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDari_Decimal(t *testing.T) {
+	if result, expected := dari.ConvertFloat(1.5, 1), "یک اعشاریه پنج"; result != expected {
+		t.Errorf("ConvertFloat(1.5, 1) = %q, want %q", result, expected)
+	}
+
+	c := NewConverter(Options{Locale: Dari, DecimalStyle: DecimalFraction})
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1.25", "یک و بیست و پنج صدم"},
+		{"0.005", "پنج هزارم"},
+		{"0.00001", "یک صد‌هزارم"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := c.ConvertDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ConvertDecimal(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ConvertDecimal(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDari_Currency(t *testing.T) {
+	if result, expected := dari.ToCurrency(250), "دو صد و پنجاه افغانی"; result != expected {
+		t.Errorf("ToCurrency(250) = %q, want %q", result, expected)
+	}
+	if result, expected := dari.ToSubunit(50), "پنجاه پول"; result != expected {
+		t.Errorf("ToSubunit(50) = %q, want %q", result, expected)
+	}
+}
+
+func TestPersian_Currency(t *testing.T) {
+	if result, expected := defaultConverter.ToCurrency(1000), ToToman(1000); result != expected {
+		t.Errorf("ToCurrency(1000) = %q, want %q", result, expected)
+	}
+	if result, expected := defaultConverter.ToSubunit(1000), ToRial(1000); result != expected {
+		t.Errorf("ToSubunit(1000) = %q, want %q", result, expected)
+	}
+}
+
+func TestDari_ParseRoundTrip(t *testing.T) {
+	for n := int64(0); n <= 20000; n++ {
+		text := dari.Convert(n)
+		result, err := dari.Parse(text)
+		if err != nil {
+			t.Fatalf("Parse(%q) unexpected error: %v", text, err)
+		}
+		if result != n {
+			t.Fatalf("Parse(%q) = %d, want %d", text, result, n)
+		}
+	}
+
+	n, _ := new(big.Int).SetString("123456789012345678901234567890123456789012", 10)
+	text := dari.ConvertBigInt(n)
+	result, err := dari.ParseBigInt(text)
+	if err != nil {
+		t.Fatalf("ParseBigInt(%q) unexpected error: %v", text, err)
+	}
+	if result.Cmp(n) != 0 {
+		t.Errorf("ParseBigInt(%q) = %s, want %s", text, result, n)
+	}
+}
+
+func TestNewConverter_InvalidLocale(t *testing.T) {
+	c := NewConverter(Options{Locale: Locale(99)})
+	if result, expected := c.Convert(100), "صد"; result != expected {
+		t.Errorf("Convert(100) = %q, want %q", result, expected)
+	}
+}
+
+var tajik = NewConverter(Options{Locale: Tajik})
+
+func TestTajik_Convert(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "сифр"},
+		{5, "панҷ"},
+		{13, "сенздаҳ"},
+		{30, "сӣ"},
+		{42, "чил ва ду"},
+		{100, "як сад"},
+		{1200, "як ҳазор ва ду сад"},
+		{2500000, "ду миллион ва панҷ сад ҳазор"},
+		{3000000000, "се миллиард"},
+		{1000000000000, "як триллион"},
+		{-919, "минус нӯҳ сад ва нуздаҳ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := tajik.Convert(tt.input); result != tt.expected {
+				t.Errorf("Convert(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTajik_ScaleNames(t *testing.T) {
+	tests := []struct {
+		index    int
+		system   ScaleSystem
+		expected string
+	}{
+		{3, ShortScale, "биллион"},
+		{11, IranianScale, "дециллион"},
+		{12, IranianScale, "ундециллион"},
+		{13, IranianScale, "дуодециллион"},
+		{18, IranianScale, "септендециллион"},
+		{21, IranianScale, "вигинтиллион"},
+		{24, IranianScale, "тресвигинтиллион"},
+		{101, IranianScale, "центиллион"},
+		{5, LongScale, "биллиард"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if name := tajikScaleNamer.scaleName(tt.index, tt.system); name != tt.expected {
+				t.Errorf("scaleName(%d) = %q, want %q", tt.index, name, tt.expected)
+			}
+		})
+	}
+	if n := len(tajikScaleNamer.groups); n != 1000 {
+		t.Errorf("group names map %d groups, want 1000", n)
+	}
+}
+
+func TestTajik_Ordinal(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{1, "аввал"},
+		{2, "дуюм"},
+		{3, "сеюм"},
+		{4, "чорум"},
+		{10, "даҳум"},
+		{30, "сиюм"},
+		{32, "сӣ ва дуюм"},
+		{100, "як садум"},
+		{1000, "як ҳазорум"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if result := tajik.ConvertOrdinal(tt.input); result != tt.expected {
+				t.Errorf("ConvertOrdinal(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	if result, expected := tajik.ConvertOrdinalAdjective(2), "дуюмин"; result != expected {
+		t.Errorf("ConvertOrdinalAdjective(2) = %q, want %q", result, expected)
+	}
+	c := NewConverter(Options{Locale: Tajik, FirstOrdinal: FirstYekom})
+	if result, expected := c.ConvertOrdinal(1), "якум"; result != expected {
+		t.Errorf("ConvertOrdinal(1) = %q, want %q", result, expected)
+	}
+}
+
+func TestTajik_Cheque(t *testing.T) {
+	if result, expected := tajik.ToRialCheque(1000), "фақат як ҳазор риёл тамом"; result != expected {
+		t.Errorf("ToRialCheque(1000) = %q, want %q", result, expected)
+	}
+	if result, expected := dari.ToTomanCheque(1500), "فقط یک هزار و پنج صد تومان تمام"; result != expected {
+		t.Errorf("dari.ToTomanCheque(1500) = %q, want %q", result, expected)
+	}
+}
+
+func TestTajik_Decimal(t *testing.T) {
+	if result, expected := tajik.ConvertFloat(2.5, 1), "ду бутун панҷ"; result != expected {
+		t.Errorf("ConvertFloat(2.5, 1) = %q, want %q", result, expected)
+	}
+
+	c := NewConverter(Options{Locale: Tajik, DecimalStyle: DecimalFraction})
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"2.5", "ду ва панҷ даҳум"},
+		{"0.03", "се садум"},
+		{"0.0001", "як даҳҳазорум"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := c.ConvertDecimal(tt.input)
+			if err != nil {
+				t.Fatalf("ConvertDecimal(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ConvertDecimal(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTajik_Currency(t *testing.T) {
+	if result, expected := tajik.ToCurrency(25), "бист ва панҷ сомонӣ"; result != expected {
+		t.Errorf("ToCurrency(25) = %q, want %q", result, expected)
+	}
+	if result, expected := tajik.ToSubunit(50), "панҷоҳ дирам"; result != expected {
+		t.Errorf("ToSubunit(50) = %q, want %q", result, expected)
+	}
+}
+
+func TestTajik_ParseRoundTrip(t *testing.T) {
+	for n := int64(-2000); n <= 20000; n++ {
+		text := tajik.Convert(n)
+		result, err := tajik.Parse(text)
+		if err != nil {
+			t.Fatalf("Parse(%q) unexpected error: %v", text, err)
+		}
+		if result != n {
+			t.Fatalf("Parse(%q) = %d, want %d", text, result, n)
+		}
+	}
+
+	n, _ := new(big.Int).SetString("123456789012345678901234567890123456789012", 10)
+	text := tajik.ConvertBigInt(n)
+	result, err := tajik.ParseBigInt(text)
+	if err != nil {
+		t.Fatalf("ParseBigInt(%q) unexpected error: %v", text, err)
+	}
+	if result.Cmp(n) != 0 {
+		t.Errorf("ParseBigInt(%q) = %s, want %s", text, result, n)
+	}
+}
+
+var (
+	finglish  = NewConverter(Options{Script: Finglish})
+	romanized = NewConverter(Options{Script: Romanized})
+)
+
+func TestFinglish_Convert(t *testing.T) {
+	tests := []struct {
+		result   string
+		expected string
+	}{
+		{finglish.Convert(0), "sefr"},
+		{finglish.Convert(17), "hefdah"},
+		{finglish.Convert(-123), "manfi sad o bist o se"},
+		{finglish.Convert(1500), "hezar o pansad"},
+		{finglish.Convert(2000000000), "do milliard"},
+		{finglish.ToToman(1500000), "yek million o pansad hezar toman"},
+		{finglish.ToRial(40), "chehel rial"},
+		{finglish.ConvertFloat(3.05, 2), "se momayez sefr panj"},
+		{finglish.ConvertOrdinal(2), "dovom"},
+		{finglish.ConvertOrdinal(3), "sevom"},
+		{finglish.ConvertOrdinal(30), "siom"},
+		{finglish.ConvertOrdinal(1001), "hezar o yekom"},
+		{finglish.ConvertOrdinalAdjective(2), "dovomin"},
+		{romanized.Convert(-123), "manfī ṣad va bīst va sih"},
+		{romanized.ToToman(1500000), "yak mīliyūn va pānṣad hazār tūmān"},
+		{romanized.ConvertFloat(3.05, 2), "sih mumayyiz ṣifr panj"},
+		{romanized.Convert(2000000000), "dū mīliyārd"},
+		{romanized.ConvertOrdinal(2), "duvum"},
+		{romanized.ConvertOrdinal(30), "sīʼum"},
+		{romanized.ConvertOrdinal(14), "chahārdahum"},
+		{finglish.ToRialCheque(1000), "faghat yek hezar rial tamam"},
+		{finglish.ToTomanCheque(2500), "faghat do hezar o pansad toman tamam"},
+		{romanized.ToRialCheque(1000), "faqaṭ yak hazār rīyāl tamām"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("got %q, want %q", tt.result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFinglish_ScaleNames(t *testing.T) {
+	tests := []struct {
+		names    *scaleNamer
+		index    int
+		system   ScaleSystem
+		expected string
+	}{
+		{latinScaleNamer, 11, IranianScale, "decillion"},
+		{latinScaleNamer, 12, IranianScale, "undecillion"},
+		{latinScaleNamer, 18, IranianScale, "septendecillion"},
+		{latinScaleNamer, 24, IranianScale, "tresvigintillion"},
+		{latinScaleNamer, 101, IranianScale, "centillion"},
+		{latinScaleNamer, 3, ShortScale, "billion"},
+		{latinScaleNamer, 5, LongScale, "billiard"},
+		{romanizedScaleNamer, 4, IranianScale, "trīliyūn"},
+		{romanizedScaleNamer, 12, IranianScale, "undecīliyūn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if name := tt.names.scaleName(tt.index, tt.system); name != tt.expected {
+				t.Errorf("scaleName(%d) = %q, want %q", tt.index, name, tt.expected)
+			}
+		})
+	}
+	if n := len(latinScaleNamer.groups); n != 1000 {
+		t.Errorf("group names map %d groups, want 1000", n)
+	}
+}
+
+func TestFinglish_Fraction(t *testing.T) {
+	c := NewConverter(Options{Script: Finglish, DecimalStyle: DecimalFraction})
+	if result, expected := c.ConvertFloat(0.25, 2), "bist o panj sadom"; result != expected {
+		t.Errorf("ConvertFloat(0.25, 2) = %q, want %q", result, expected)
+	}
+}
+
+func TestFinglish_ParseRoundTrip(t *testing.T) {
+	for _, c := range []*Converter{finglish, romanized} {
+		for n := int64(0); n <= 20000; n++ {
+			text := c.Convert(n)
+			result, err := c.Parse(text)
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", text, err)
+			}
+			if result != n {
+				t.Fatalf("Parse(%q) = %d, want %d", text, result, n)
+			}
+		}
+	}
+}
+
+func TestScript_NativeLocales(t *testing.T) {
+	c := NewConverter(Options{Locale: Tajik, Script: Finglish})
+	if result, expected := c.Convert(5), "панҷ"; result != expected {
+		t.Errorf("Convert(5) = %q, want %q", result, expected)
+	}
+	c = NewConverter(Options{Script: Script(99)})
+	if result, expected := c.Convert(5), "پنج"; result != expected {
+		t.Errorf("Convert(5) = %q, want %q", result, expected)
+	}
+}
@@ -0,0 +1,331 @@
+package num2persian
+
+import (
+	"math/big"
+	"strings"
+)
+
+// CorrectionKind classifies a correction made by the lenient parser.
+type CorrectionKind int
+
+const (
+	// LetterCorrection replaces Arabic letter forms with Persian ones and
+	// removes diacritics, tatweel and direction marks.
+	LetterCorrection CorrectionKind = iota
+	// SpacingCorrection splits words at ZWNJ, separates words written
+	// together, as in "یکهزار", and joins words written apart, as in "سه صد".
+	SpacingCorrection
+	// SpellingCorrection replaces a spelling variant or common typo with the
+	// vocabulary word, as "هیجده" with "هجده" and "ملیون" with "میلیون".
+	SpellingCorrection
+	// SeparatorCorrection reads a comma as "و" and drops a repeated "و".
+	SeparatorCorrection
+)
+
+func (k CorrectionKind) String() string {
+	switch k {
+	case LetterCorrection:
+		return "letter"
+	case SpacingCorrection:
+		return "spacing"
+	case SpellingCorrection:
+		return "spelling"
+	case SeparatorCorrection:
+		return "separator"
+	}
+	return "unknown"
+}
+
+// Correction is a change the lenient parser made to its input. From is the
+// text as written and To the text it was read as; To is empty when From was
+// dropped.
+type Correction struct {
+	Kind     CorrectionKind
+	From, To string
+}
+
+// spellingVariants maps common alternative spellings and typos to the words
+// of the vocabulary tables.
+var spellingVariants = map[string]string{
+	"یه":     ones[1],
+	"چار":    ones[4],
+	"شیش":    ones[6],
+	"چارده":  teens[4],
+	"پونزده": teens[5],
+	"شونزده": teens[6],
+	"هیفده":  teens[7],
+	"هیجده":  teens[8],
+	"هژده":   teens[8],
+	"هیژده":  teens[8],
+	"بیس":    tens[2],
+	"پنجا":   tens[5],
+	"یکصد":   hundreds[1],
+	"چارصد":  hundreds[4],
+	"پنجصد":  hundreds[5],
+	"پونصد":  hundreds[5],
+	"شیشصد":  hundreds[6],
+	"هفصد":   hundreds[7],
+	"هشصد":   hundreds[8],
+	"ملیون":  scales[2],
+	"میلون":  scales[2],
+	"ملیارد": scales[3],
+	"میلارد": scales[3],
+	"ترلیون": scales[4],
+}
+
+// letterReplacer maps Arabic letters to their Persian forms and removes
+// diacritics, tatweel and direction marks.
+var letterReplacer = strings.NewReplacer(
+	"ي", "ی",
+	"ى", "ی",
+	"ك", "ک",
+	"\u0640", "",
+	"\u064b", "", "\u064c", "", "\u064d", "", "\u064e", "",
+	"\u064f", "", "\u0650", "", "\u0651", "", "\u0652", "",
+	"\u200e", "", "\u200f", "",
+)
+
+// ParseLenient converts Persian number words typed by hand to an int64,
+// reporting the corrections it made. Beyond what Parse accepts, it reads
+// Arabic letter forms, ZWNJ or missing spaces between words, spelling
+// variants such as "هیجده" and "شونزده", typos such as "ملیون", split
+// hundreds such as "سه صد" and commas in place of "و". Parse remains strict.
+func ParseLenient(s string) (int64, []Correction, error) {
+	return defaultConverter.ParseLenient(s)
+}
+
+// ParseBigIntLenient is like ParseLenient but returns a big.Int.
+func ParseBigIntLenient(s string) (*big.Int, []Correction, error) {
+	return defaultConverter.ParseBigIntLenient(s)
+}
+
+// ParseLenient converts number words in the Converter's vocabulary to an
+// int64, correcting the input as the package-level ParseLenient does.
+func (c *Converter) ParseLenient(s string) (int64, []Correction, error) {
+	n, corrections, err := c.parseLenient(s, int64Limit)
+	if err != nil {
+		return 0, corrections, err
+	}
+	if !n.IsInt64() {
+		return 0, corrections, &ParseError{Input: s, Reason: "value out of int64 range"}
+	}
+	return n.Int64(), corrections, nil
+}
+
+// ParseBigIntLenient converts number words in the Converter's vocabulary to
+// a big.Int of up to MaxParseDigits digits, correcting the input as the
+// package-level ParseLenient does.
+func (c *Converter) ParseBigIntLenient(s string) (*big.Int, []Correction, error) {
+	return c.parseLenient(s, bigIntLimit)
+}
+
+func (c *Converter) parseLenient(s string, limit parseLimit) (*big.Int, []Correction, error) {
+	words, corrections := c.normalizeWords(s)
+	n, err := c.parseWords(strings.Join(words, " "), limit)
+	if err != nil {
+		if perr, ok := err.(*ParseError); ok {
+			perr.Input = s
+		}
+		return nil, corrections, err
+	}
+	return n, corrections, nil
+}
+
+// normalizeWords rewrites number words typed by hand into the Converter's
+// vocabulary, returning the resulting words and the corrections made.
+func (c *Converter) normalizeWords(s string) ([]string, []Correction) {
+	connector := c.connector()
+	var words []string
+	var corrections []Correction
+	correct := func(kind CorrectionKind, from, to string) {
+		corrections = append(corrections, Correction{kind, from, to})
+	}
+	appendWord := func(w string) {
+		n := len(words)
+		if w == connector && n > 0 && words[n-1] == w {
+			correct(SeparatorCorrection, w, "")
+			return
+		}
+		if w == c.hundreds[1] && n > 0 {
+			// "سه صد" names a single hundreds word unless the vocabulary
+			// writes it apart, as Dari does.
+			pair := words[n-1] + " " + w
+			if info, ok := c.words[words[n-1]]; ok && info.kind == kindOnes {
+				if _, ok := c.words[pair]; !ok {
+					words[n-1] = c.hundreds[info.value]
+					correct(SpacingCorrection, pair, words[n-1])
+					return
+				}
+			}
+		}
+		words = append(words, w)
+	}
+	addPiece := func(piece string) {
+		if n := len(words); n > 0 {
+			// The second half of a word written in two, as "سه صد" in Dari.
+			if _, ok := c.words[words[n-1]+" "+piece]; ok {
+				words = append(words, piece)
+				return
+			}
+		}
+		for _, w := range c.correctWord(piece, correct) {
+			appendWord(w)
+		}
+	}
+
+	for _, field := range strings.Fields(s) {
+		if w := letterReplacer.Replace(field); w != field {
+			correct(LetterCorrection, field, w)
+			field = w
+		}
+		for _, piece := range splitCommas(field) {
+			switch {
+			case piece == "," || piece == "،":
+				correct(SeparatorCorrection, piece, connector)
+				appendWord(connector)
+			case strings.Contains(piece, zwnj):
+				parts := strings.Fields(strings.ReplaceAll(piece, zwnj, " "))
+				correct(SpacingCorrection, piece, strings.Join(parts, " "))
+				for _, part := range parts {
+					addPiece(part)
+				}
+			default:
+				addPiece(piece)
+			}
+		}
+	}
+	return words, corrections
+}
+
+// correctWord returns the vocabulary words spelled w: w itself when it is a
+// word, its standard spelling when it is a variant, or the words it is made
+// of when they were written together.
+func (c *Converter) correctWord(w string, correct func(CorrectionKind, string, string)) []string {
+	if c.isVocabularyWord(w) {
+		return []string{w}
+	}
+	if v, ok := c.variant(w); ok {
+		correct(SpellingCorrection, w, v)
+		return []string{v}
+	}
+	parts := c.segment(w)
+	if parts == nil {
+		return []string{w}
+	}
+	correct(SpacingCorrection, w, strings.Join(parts, " "))
+	for i, part := range parts {
+		if c.isVocabularyWord(part) {
+			continue
+		}
+		if v, ok := c.variant(part); ok {
+			correct(SpellingCorrection, part, v)
+			parts[i] = v
+		}
+	}
+	return parts
+}
+
+// isVocabularyWord reports whether w is read by the parser as it stands.
+func (c *Converter) isVocabularyWord(w string) bool {
+	return w == c.connector() || w == c.zero || w == c.negative || c.isNumberWord(w)
+}
+
+// variant returns the Converter's word for w when w is a spelling variant or
+// a word of the canonical tables that the Converter spells differently, as
+// Dari does "هجده" and the colloquial style "پانصد".
+func (c *Converter) variant(w string) (string, bool) {
+	canonical := w
+	if v, ok := spellingVariants[w]; ok {
+		canonical = v
+	}
+	info, ok := defaultConverter.words[canonical]
+	if !ok {
+		return "", false
+	}
+	v := c.wordFor(info)
+	return v, v != "" && v != w && c.isNumberWord(v)
+}
+
+// wordFor returns the Converter's vocabulary word described by info.
+func (c *Converter) wordFor(info wordInfo) string {
+	switch info.kind {
+	case kindOnes:
+		return c.ones[info.value]
+	case kindTeens:
+		return c.teens[info.value-10]
+	case kindTens:
+		return c.tens[info.value/10]
+	case kindHundreds:
+		return c.hundreds[info.value/100]
+	}
+	if info.value < len(c.scales) {
+		return c.scales[info.value]
+	}
+	return ""
+}
+
+// splitCommas splits s at Latin and Persian commas, keeping each comma as a
+// piece of its own.
+func splitCommas(s string) []string {
+	var pieces []string
+	start := 0
+	for i, r := range s {
+		if r != ',' && r != '،' {
+			continue
+		}
+		if start < i {
+			pieces = append(pieces, s[start:i])
+		}
+		pieces = append(pieces, string(r))
+		start = i + len(string(r))
+	}
+	if start < len(s) {
+		pieces = append(pieces, s[start:])
+	}
+	return pieces
+}
+
+// maxSegmentLen bounds the length of a word that segment splits.
+const maxSegmentLen = 256
+
+// segment splits w into the fewest vocabulary words or variants written
+// together, as "یکهزار" is. It returns nil when w cannot be split into two
+// or more of them.
+func (c *Converter) segment(w string) []string {
+	if len(w) > maxSegmentLen {
+		return nil
+	}
+	// Generated scale names are not tried, as decoding them for every
+	// substring would be slow.
+	isPart := func(s string) bool {
+		if _, ok := c.words[s]; ok || s == c.connector() {
+			return true
+		}
+		_, ok := c.variant(s)
+		return ok
+	}
+
+	// best[i] is the fewest words that spell w[:i], and cut[i] is where the
+	// last of them starts; best[i] is 0 when w[:i] cannot be spelled.
+	best := make([]int, len(w)+1)
+	cut := make([]int, len(w)+1)
+	for end := 1; end <= len(w); end++ {
+		for start := 0; start < end; start++ {
+			if (start > 0 && best[start] == 0) || !isPart(w[start:end]) {
+				continue
+			}
+			if count := best[start] + 1; best[end] == 0 || count < best[end] {
+				best[end], cut[end] = count, start
+			}
+		}
+	}
+	if best[len(w)] < 2 {
+		return nil
+	}
+
+	parts := make([]string, best[len(w)])
+	for end, i := len(w), len(parts)-1; end > 0; end, i = cut[end], i-1 {
+		parts[i] = w[cut[end]:end]
+	}
+	return parts
+}
@@ -0,0 +1,133 @@
+package num2persian
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestParseLenient(t *testing.T) {
+	tests := []struct {
+		input       string
+		expected    int64
+		corrections []Correction
+	}{
+		{"هجده", 18, nil},
+		{"هیجده", 18, []Correction{{SpellingCorrection, "هیجده", "هجده"}}},
+		{"شونزده", 16, []Correction{{SpellingCorrection, "شونزده", "شانزده"}}},
+		{"یکهزار", 1000, []Correction{{SpacingCorrection, "یکهزار", "یک هزار"}}},
+		{"صدوبیست", 120, []Correction{{SpacingCorrection, "صدوبیست", "صد و بیست"}}},
+		{"سیصدوهیجده", 318, []Correction{
+			{SpacingCorrection, "سیصدوهیجده", "سیصد و هیجده"},
+			{SpellingCorrection, "هیجده", "هجده"},
+		}},
+		{"يك ميليون", 1000000, []Correction{
+			{LetterCorrection, "يك", "یک"},
+			{LetterCorrection, "ميليون", "میلیون"},
+		}},
+		{"ده‌هزار", 10000, []Correction{{SpacingCorrection, "ده‌هزار", "ده هزار"}}},
+		{"دو ملیون", 2000000, []Correction{{SpellingCorrection, "ملیون", "میلیون"}}},
+		{"یک میلیون، پانصد هزار", 1500000, []Correction{{SeparatorCorrection, "،", "و"}}},
+		{"هزار,دویست", 1200, []Correction{{SeparatorCorrection, ",", "و"}}},
+		{"هزار، و دویست", 1200, []Correction{
+			{SeparatorCorrection, "،", "و"},
+			{SeparatorCorrection, "و", ""},
+		}},
+		{"سه صد و پنج", 305, []Correction{{SpacingCorrection, "سه صد", "سیصد"}}},
+		{"یه میلیون و پونصد", 1000500, []Correction{
+			{SpellingCorrection, "یه", "یک"},
+			{SpellingCorrection, "پونصد", "پانصد"},
+		}},
+		{"منفی بیستُ و یک", -21, []Correction{{LetterCorrection, "بیستُ", "بیست"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, corrections, err := ParseLenient(tt.input)
+			if err != nil {
+				t.Fatalf("ParseLenient(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseLenient(%q) = %d, want %d", tt.input, result, tt.expected)
+			}
+			if !reflect.DeepEqual(corrections, tt.corrections) {
+				t.Errorf("ParseLenient(%q) corrections = %+v, want %+v", tt.input, corrections, tt.corrections)
+			}
+		})
+	}
+}
+
+func TestParseLenient_Strict(t *testing.T) {
+	for _, input := range []string{"هیجده", "یکهزار", "يك", "ده‌هزار", "دو ملیون", "هزار، دویست"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) accepted input that only ParseLenient corrects", input)
+		}
+	}
+}
+
+func TestParseLenient_Errors(t *testing.T) {
+	for _, input := range []string{"", "سیب", "بیست پنج", "یک میلیون و سیب", "هزار هزار"} {
+		t.Run(input, func(t *testing.T) {
+			_, _, err := ParseLenient(input)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseLenient(%q) error = %v, want *ParseError", input, err)
+			}
+			if perr.Input != input {
+				t.Errorf("ParseError.Input = %q, want %q", perr.Input, input)
+			}
+		})
+	}
+}
+
+func TestParseLenient_Locales(t *testing.T) {
+	// Dari writes hundreds apart and says هژده, so neither is corrected.
+	n, corrections, err := dari.ParseLenient("سه صد و هژده")
+	if err != nil || n != 318 || corrections != nil {
+		t.Errorf("dari.ParseLenient = %d, %+v, %v, want 318 without corrections", n, corrections, err)
+	}
+	n, corrections, err = dari.ParseLenient("یکصد")
+	expected := []Correction{{SpellingCorrection, "یکصد", "یک صد"}}
+	if err != nil || n != 100 || !reflect.DeepEqual(corrections, expected) {
+		t.Errorf("dari.ParseLenient(\"یکصد\") = %d, %+v, %v, want 100, %+v", n, corrections, err, expected)
+	}
+
+	n, corrections, err = dari.ParseLenient("هجده")
+	expected = []Correction{{SpellingCorrection, "هجده", "هژده"}}
+	if err != nil || n != 18 || !reflect.DeepEqual(corrections, expected) {
+		t.Errorf("dari.ParseLenient(\"هجده\") = %d, %+v, %v, want 18, %+v", n, corrections, err, expected)
+	}
+
+	spoken := NewConverter(Options{Colloquial: true})
+	n, corrections, err = spoken.ParseLenient("پانصد و بیس")
+	expected = []Correction{{SpellingCorrection, "پانصد", "پونصد"}}
+	if err != nil || n != 520 || !reflect.DeepEqual(corrections, expected) {
+		t.Errorf("spoken.ParseLenient = %d, %+v, %v, want 520, %+v", n, corrections, err, expected)
+	}
+}
+
+func TestParseLenient_RoundTrip(t *testing.T) {
+	for n := int64(-5000); n <= 2000000; n += 997 {
+		text := Convert(n)
+		result, corrections, err := ParseLenient(text)
+		if err != nil || result != n || corrections != nil {
+			t.Fatalf("ParseLenient(%q) = %d, %+v, %v, want %d", text, result, corrections, err, n)
+		}
+	}
+}
+
+func TestParseBigIntLenient(t *testing.T) {
+	n, corrections, err := ParseBigIntLenient("هزار ملیارد ملیارد ملیارد ملیارد")
+	if err == nil {
+		t.Errorf("ParseBigIntLenient accepted repeated scales: %s", n)
+	}
+	if len(corrections) != 4 {
+		t.Errorf("corrections = %+v, want 4", corrections)
+	}
+
+	n, _, err = ParseBigIntLenient("یک آندسیلیون")
+	if err != nil || n.Cmp(new(big.Int).Exp(big.NewInt(1000), big.NewInt(12), nil)) != 0 {
+		t.Errorf("ParseBigIntLenient(\"یک آندسیلیون\") = %s, %v", n, err)
+	}
+}
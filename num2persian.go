@@ -8,10 +8,16 @@ import (
 	"strings"
 )
 
+// zwnj is the zero-width non-joiner used inside compound Persian words.
+const zwnj = "\u200c"
+
 var (
-	zero      = "صفر"
-	negative  = "منفی"
-	separator = " و "
+	zero         = "صفر"
+	negative     = "منفی"
+	separator    = " و "
+	decimalPoint = "ممیز"
+	notANumber   = "نامعین"
+	infinity     = "بی‌نهایت"
 
 	ones = []string{"", "یک", "دو", "سه", "چهار", "پنج", "شش", "هفت", "هشت", "نه"}
 
@@ -41,171 +47,245 @@ var (
 
 // Convert converts an integer to Persian text.
 func Convert(n int64) string {
+	return defaultConverter.Convert(n)
+}
+
+// ConvertInt converts an int to Persian text.
+func ConvertInt(n int) string {
+	return defaultConverter.ConvertInt(n)
+}
+
+// ConvertUint64 converts a uint64 to Persian text.
+func ConvertUint64(n uint64) string {
+	return defaultConverter.ConvertUint64(n)
+}
+
+// ConvertUint converts a uint to Persian text.
+func ConvertUint(n uint) string {
+	return defaultConverter.ConvertUint(n)
+}
+
+// ConvertBigInt converts a big.Int to Persian text. Numbers of any size are
+// written, but ParseBigInt reads back only those of up to MaxParseDigits
+// digits.
+func ConvertBigInt(n *big.Int) string {
+	return defaultConverter.ConvertBigInt(n)
+}
+
+// ConvertFloat converts a float64 to Persian text with specified decimal precision.
+// The fraction is read after "ممیز" with its leading zeros spelled out, so
+// 1.05 becomes "یک ممیز صفر پنج". Use ConvertFloatWithStyle for other styles.
+func ConvertFloat(n float64, precision int) string {
+	return defaultConverter.ConvertFloat(n, precision)
+}
+
+// ConvertFloatWithStyle converts a float64 to Persian text with specified
+// decimal precision, reading the fraction in the given style.
+func ConvertFloatWithStyle(n float64, precision int, style DecimalStyle) string {
+	return defaultConverter.convertFloat(n, precision, style)
+}
+
+// ConvertString parses a string and converts it to Persian text.
+// Persian (۱۲۳) and Arabic-Indic (١٢٣) digits, the "٫" decimal mark, "٬" or
+// "," thousands separators, underscores between digits and a leading "+" are
+// accepted. A number in exponent notation, such as "1.5e3" or "1e-5", is read
+// exactly: the decimal point is moved in the digits rather than going
+// through float64, and the fraction keeps the digits it is written with.
+func ConvertString(s string) (string, error) {
+	return defaultConverter.ConvertString(s)
+}
+
+// ConvertStringWithStyle is like ConvertString but reads the fraction of
+// decimal input in the given style.
+func ConvertStringWithStyle(s string, style DecimalStyle) (string, error) {
+	return defaultConverter.convertString(s, style)
+}
+
+// Convert converts an integer to text.
+func (c *Converter) Convert(n int64) string {
 	if n == 0 {
-		return zero
+		return c.zero
 	}
 	if n < 0 {
-		if n == math.MinInt64 {
-			return negative + " " + ConvertBigInt(big.NewInt(n).Abs(big.NewInt(n)))
-		}
-		return negative + " " + Convert(-n)
+		// -n overflows for math.MinInt64, but its magnitude fits in a uint64.
+		return c.negative + " " + c.convertPositive(uint64(-(n+1))+1)
 	}
-	return convertPositive(uint64(n))
+	return c.convertPositive(uint64(n))
 }
 
-// ConvertInt converts an int to Persian text.
-func ConvertInt(n int) string {
-	return Convert(int64(n))
+// ConvertUint64 converts a uint64 to text.
+func (c *Converter) ConvertUint64(n uint64) string {
+	if n == 0 {
+		return c.zero
+	}
+	return c.convertPositive(n)
 }
 
-// ConvertBigInt converts a big.Int to Persian text.
-func ConvertBigInt(n *big.Int) string {
+// ConvertUint converts a uint to text.
+func (c *Converter) ConvertUint(n uint) string {
+	return c.ConvertUint64(uint64(n))
+}
+
+// ConvertInt converts an int to text.
+func (c *Converter) ConvertInt(n int) string {
+	return c.Convert(int64(n))
+}
+
+// ConvertBigInt converts a big.Int to text.
+func (c *Converter) ConvertBigInt(n *big.Int) string {
 	if n == nil || n.Sign() == 0 {
-		return zero
+		return c.zero
 	}
 	if n.Sign() < 0 {
-		return negative + " " + ConvertBigInt(new(big.Int).Abs(n))
+		return c.negative + " " + c.ConvertBigInt(new(big.Int).Abs(n))
 	}
-	return convertBigIntPositive(n)
+	return c.convertBigIntPositive(n)
 }
 
-// ConvertFloat converts a float64 to Persian text with specified decimal precision.
-func ConvertFloat(n float64, precision int) string {
+// ConvertFloat converts a float64 to text with specified decimal precision,
+// reading the fraction in the Converter's decimal style.
+func (c *Converter) ConvertFloat(n float64, precision int) string {
+	return c.convertFloat(n, precision, c.decimalStyle)
+}
+
+func (c *Converter) convertFloat(n float64, precision int, style DecimalStyle) string {
 	if precision < 0 {
 		precision = 0
 	}
 	if math.IsNaN(n) {
-		return "نامعین"
+		return c.notANumber
 	}
 	if math.IsInf(n, 1) {
-		return "بی‌نهایت"
+		return c.infinity
 	}
 	if math.IsInf(n, -1) {
-		return negative + " بی‌نهایت"
+		return c.negative + " " + c.infinity
 	}
 
-	isNegative := n < 0
-	if isNegative {
-		n = -n
-	}
+	text, _ := c.decimalText(strconv.FormatFloat(n, 'f', precision, 64), style)
+	return text
+}
 
-	intPart := int64(n)
-	multiplier := math.Pow(10, float64(precision))
-	decimalPart := int64(math.Round((n - float64(intPart)) * multiplier))
+// ConvertString parses a string and converts it to text, accepting the same
+// input as the package-level ConvertString.
+func (c *Converter) ConvertString(s string) (string, error) {
+	return c.convertString(s, c.decimalStyle)
+}
 
-	if decimalPart >= int64(multiplier) {
-		intPart++
-		decimalPart = 0
+func (c *Converter) convertString(s string, style DecimalStyle) (string, error) {
+	input := strings.TrimSpace(s)
+	s, reason := normalizeNumber(s)
+	if reason != "" {
+		return "", &ParseError{Input: input, Reason: reason}
 	}
-
-	var result strings.Builder
-	if isNegative {
-		result.WriteString(negative + " ")
+	if shifted, reason := shiftExponent(s); reason != "" {
+		return "", &ParseError{Input: input, Reason: reason}
+	} else if shifted != "" {
+		s = shifted
 	}
-	result.WriteString(Convert(intPart))
-
-	if precision > 0 {
-		result.WriteString(" ممیز ")
-		if decimalPart == 0 {
-			result.WriteString(zero)
-		} else {
-			result.WriteString(Convert(decimalPart))
-		}
-	}
-	return result.String()
-}
-
-// ConvertString parses a string and converts it to Persian text.
-func ConvertString(s string) (string, error) {
-	s = strings.TrimSpace(s)
 
 	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
-		return Convert(n), nil
+		return c.Convert(n), nil
+	}
+	// Integers beyond int64 must not go through ParseFloat, which would
+	// accept them with lost precision.
+	if n, ok := new(big.Int).SetString(s, 10); ok {
+		return c.ConvertBigInt(n), nil
+	}
+	if text, ok := c.decimalText(s, style); ok {
+		return text, nil
 	}
 	if n, err := strconv.ParseFloat(s, 64); err == nil {
 		precision := 0
 		if idx := strings.Index(s, "."); idx != -1 {
 			precision = len(s) - idx - 1
 		}
-		return ConvertFloat(n, precision), nil
-	}
-
-	n := new(big.Int)
-	if _, ok := n.SetString(s, 10); ok {
-		return ConvertBigInt(n), nil
+		return c.convertFloat(n, precision, style), nil
 	}
-	return "", &ParseError{Input: s}
+	return "", &ParseError{Input: input}
 }
 
-// ParseError is returned when ConvertString fails to parse the input.
+// ParseError is returned when ConvertString or Parse fails to parse the input.
+// Reason, when set, describes what was wrong with the input.
 type ParseError struct {
-	Input string
+	Input  string
+	Reason string
 }
 
 func (e *ParseError) Error() string {
-	return "num2persian: cannot parse \"" + e.Input + "\" as a number"
+	msg := "num2persian: cannot parse \"" + e.Input + "\" as a number"
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
 }
 
-func formatGroupWithScale(group int, scaleIndex int) string {
-	text := convertGroup(group)
-	if scaleIndex > 0 && text != "" {
-		if scaleIndex == 1 && group == 1 {
-			return scales[scaleIndex]
-		}
-		text += " " + scales[scaleIndex]
+func (c *Converter) formatGroupWithScale(group int, scaleIndex int) string {
+	if group <= 0 || group > 999 {
+		return ""
 	}
-	return text
+	return string(c.appendGroupWithScale(nil, group, scaleIndex))
 }
 
-func convertPositive(n uint64) string {
+func (c *Converter) convertPositive(n uint64) string {
 	if n == 0 {
 		return ""
 	}
-
-	var parts []string
-	scaleIndex := 0
-
-	for n > 0 && scaleIndex < len(scales) {
-		group := int(n % 1000)
-		n /= 1000
-
-		if group > 0 {
-			parts = append([]string{formatGroupWithScale(group, scaleIndex)}, parts...)
-		}
-		scaleIndex++
-	}
-	return strings.Join(parts, separator)
+	var buf [512]byte
+	return string(c.appendPositive(buf[:0], n))
 }
 
-func convertBigIntPositive(n *big.Int) string {
+func (c *Converter) convertBigIntPositive(n *big.Int) string {
 	if n.Sign() == 0 {
 		return ""
 	}
+	if n.IsUint64() {
+		return c.convertPositive(n.Uint64())
+	}
+	digits := n.Text(10)
+	return string(c.appendDigitGroups(make([]byte, 0, len(digits)*16), digits))
+}
 
-	var parts []string
-	scaleIndex := 0
-	thousand := big.NewInt(1000)
-	remaining := new(big.Int).Set(n)
-	group := new(big.Int)
-
-	for remaining.Sign() > 0 && scaleIndex < len(scales) {
-		remaining.DivMod(remaining, thousand, group)
-		groupVal := int(group.Int64())
-
-		if groupVal > 0 {
-			parts = append([]string{formatGroupWithScale(groupVal, scaleIndex)}, parts...)
+// appendDigitGroups appends the text of a positive decimal string, reading it
+// in three-digit groups from the most significant end. When scales are
+// stacked, group i is written with scale i%top, where top is the largest
+// scale, and every complete run of top groups is followed by that scale once
+// more, so 10^36 becomes "هزار دسیلیون".
+func (c *Converter) appendDigitGroups(dst []byte, digits string) []byte {
+	top := c.stackIndex()
+	wrote := false
+	for i := (len(digits) - 1) / 3; i >= 0; i-- {
+		end := len(digits) - i*3
+		if group := atoiGroup(digits[max(end-3, 0):end]); group > 0 {
+			if wrote {
+				dst = append(dst, c.separator...)
+			}
+			if group == 1 && i > 0 && i%top == 0 {
+				// One before a stacked scale.
+				dst = append(dst, c.scaleOne...)
+			} else {
+				dst = c.appendGroupWithScale(dst, group, i%top)
+			}
+			wrote = true
+		}
+		if i > 0 && i%top == 0 && wrote {
+			dst = append(dst, ' ')
+			dst = c.appendScale(dst, top)
 		}
-		scaleIndex++
 	}
+	return dst
+}
 
-	if remaining.Sign() > 0 {
-		remainingText := convertBigIntPositive(remaining)
-		parts = append([]string{remainingText + " " + scales[len(scales)-1]}, parts...)
+// atoiGroup converts a group of up to three ASCII digits.
+func atoiGroup(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
 	}
-	return strings.Join(parts, separator)
+	return n
 }
 
-func convertGroup(n int) string {
+func (c *Converter) convertGroup(n int) string {
 	if n <= 0 || n > 999 {
 		return ""
 	}
@@ -213,24 +293,24 @@ func convertGroup(n int) string {
 	var parts []string
 
 	if h := n / 100; h > 0 {
-		parts = append(parts, hundreds[h])
+		parts = append(parts, c.hundreds[h])
 	}
 
 	remainder := n % 100
 	if remainder > 0 {
 		if remainder < 10 {
-			parts = append(parts, ones[remainder])
+			parts = append(parts, c.ones[remainder])
 		} else if remainder < 20 {
-			parts = append(parts, teens[remainder-10])
+			parts = append(parts, c.teens[remainder-10])
 		} else {
 			t := remainder / 10
 			o := remainder % 10
 			if o > 0 {
-				parts = append(parts, tens[t]+separator+ones[o])
+				parts = append(parts, c.tens[t]+c.separator+c.ones[o])
 			} else {
-				parts = append(parts, tens[t])
+				parts = append(parts, c.finalTens[t])
 			}
 		}
 	}
-	return strings.Join(parts, separator)
+	return strings.Join(parts, c.separator)
 }
@@ -3,6 +3,8 @@ package num2persian
 import (
 	"math"
 	"math/big"
+	"math/rand"
+	"strings"
 	"testing"
 )
 
@@ -403,6 +405,11 @@ func TestConvertBigInt(t *testing.T) {
 		{"9000000000000000000000000000000000", "نه دسیلیون"},
 		// Compound large number
 		{"1001000000000000000000000000000000", "یک دسیلیون و یک نونیلیون"},
+		// Beyond the scales table
+		{"1000000000000000000000000000000000000", "یک آندسیلیون"},
+		{"2000000000000000000000000000000000001", "دو آندسیلیون و یک"},
+		{"1000000000000000000000000000000000000000000000000000000000000000000", "یک آنویجینتیلیون"},
+		{"1" + strings.Repeat("0", 303), "یک سنتیلیون"},
 	}
 
 	for _, tt := range tests {
@@ -417,6 +424,74 @@ func TestConvertBigInt(t *testing.T) {
 	}
 }
 
+// stackedBigInt is the straightforward recursive reading of a big.Int that
+// ConvertBigInt must agree with: whatever lies above the largest scale is
+// written as a number followed by that scale.
+func stackedBigInt(c *Converter, n *big.Int) string {
+	top := len(c.scales) - 1
+	base := new(big.Int).Exp(big.NewInt(1000), big.NewInt(int64(top)), nil)
+	high, low := new(big.Int).QuoRem(n, base, new(big.Int))
+
+	var parts []string
+	if high.Sign() > 0 {
+		parts = append(parts, stackedBigInt(c, high)+" "+c.scales[top])
+	}
+	if low.Sign() > 0 {
+		parts = append(parts, c.ConvertBigInt(low))
+	}
+	return strings.Join(parts, c.separator)
+}
+
+func TestConvertBigInt_StackScales(t *testing.T) {
+	c := NewConverter(Options{StackScales: true})
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1000000000000000000000000000000000", "یک دسیلیون"},
+		{"1000000000000000000000000000000000000", "هزار دسیلیون"},
+		{"2000000000000000000000000000000000001", "دو هزار دسیلیون و یک"},
+		{"1000000000000000000000000000000000000000000000000000000000000000000", "یک دسیلیون دسیلیون"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, _ := new(big.Int).SetString(tt.input, 10)
+			if result := c.ConvertBigInt(n); result != tt.expected {
+				t.Errorf("ConvertBigInt(%s) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertBigInt_Stacking(t *testing.T) {
+	converters := []*Converter{
+		NewConverter(Options{StackScales: true}),
+		NewConverter(Options{Scales: []string{"", "هزار", "میلیون"}, OneThousand: true}),
+	}
+	rng := rand.New(rand.NewSource(1))
+
+	for _, c := range converters {
+		for i := 0; i < 500; i++ {
+			digits := make([]byte, 1+rng.Intn(150))
+			for j := range digits {
+				// Favour zeros so that whole groups and levels are empty.
+				if rng.Intn(3) > 0 {
+					digits[j] = '0'
+				} else {
+					digits[j] = byte('0' + rng.Intn(10))
+				}
+			}
+			digits[0] = byte('1' + rng.Intn(9))
+			n, _ := new(big.Int).SetString(string(digits), 10)
+
+			if result, expected := c.ConvertBigInt(n), stackedBigInt(c, n); result != expected {
+				t.Fatalf("ConvertBigInt(%s) = %q, want %q", n, result, expected)
+			}
+		}
+	}
+}
+
 func TestConvertBigInt_Nil(t *testing.T) {
 	result := ConvertBigInt(nil)
 	if result != "صفر" {
@@ -483,6 +558,13 @@ func TestConvertString(t *testing.T) {
 		{"12.5", "دوازده ممیز پنج", false},
 		{"abc", "", true},
 		{"12.34.56", "", true},
+		{"1e-5", "صفر ممیز صفر صفر صفر صفر یک", false},
+		{"1.5e3", "هزار و پانصد", false},
+		{"-2.5E+1", "منفی بیست و پنج", false},
+		{"1.23456789e2", "صد و بیست و سه ممیز چهارصد و پنجاه و شش هزار و هفتصد و هشتاد و نه", false},
+		{"12345678901234567890.5e0", "دوازده کوینتیلیون و سیصد و چهل و پنج کوادریلیون و ششصد و هفتاد و هشت تریلیون و نهصد و یک میلیارد و دویست و سی و چهار میلیون و پانصد و شصت و هفت هزار و هشتصد و نود ممیز پنج", false},
+		{"1e", "", true},
+		{"1e100000", "", true},
 	}
 
 	for _, tt := range tests {
@@ -512,6 +594,53 @@ func TestConvertInt(t *testing.T) {
 	}
 }
 
+func TestConvertUint64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    uint64
+		expected string
+	}{
+		{"Zero", 0, "صفر"},
+		{"Small", 42, "چهل و دو"},
+		{"MaxInt64+1", math.MaxInt64 + 1, "نه کوینتیلیون و دویست و بیست و سه کوادریلیون و سیصد و هفتاد و دو تریلیون و سی و شش میلیارد و هشتصد و پنجاه و چهار میلیون و هفتصد و هفتاد و پنج هزار و هشتصد و هشت"},
+		{"MaxUint64", math.MaxUint64, "هجده کوینتیلیون و چهارصد و چهل و شش کوادریلیون و هفتصد و چهل و چهار تریلیون و هفتاد و سه میلیارد و هفتصد و نه میلیون و پانصد و پنجاه و یک هزار و ششصد و پانزده"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ConvertUint64(tt.input)
+			if result != tt.expected {
+				t.Errorf("ConvertUint64(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+			want := ConvertBigInt(new(big.Int).SetUint64(tt.input))
+			if result != want {
+				t.Errorf("ConvertUint64(%d) = %q, ConvertBigInt gives %q", tt.input, result, want)
+			}
+		})
+	}
+}
+
+func TestConvertUint(t *testing.T) {
+	result := ConvertUint(42)
+	expected := "چهل و دو"
+	if result != expected {
+		t.Errorf("ConvertUint(42) = %q, want %q", result, expected)
+	}
+}
+
+func TestConvert_MinInt64(t *testing.T) {
+	result := Convert(math.MinInt64)
+	expected := "منفی " + ConvertUint64(math.MaxInt64+1)
+	if result != expected {
+		t.Errorf("Convert(MinInt64) = %q, want %q", result, expected)
+	}
+	allocs := testing.AllocsPerRun(10, func() { _ = Convert(math.MinInt64) })
+	want := testing.AllocsPerRun(10, func() { _ = Convert(math.MinInt64 + 1) })
+	if allocs > want {
+		t.Errorf("Convert(MinInt64) allocates %v times, Convert(MinInt64+1) %v", allocs, want)
+	}
+}
+
 func TestParseError(t *testing.T) {
 	err := &ParseError{Input: "test"}
 	expected := `num2persian: cannot parse "test" as a number`
@@ -539,6 +668,20 @@ func BenchmarkConvert_Large(b *testing.B) {
 	}
 }
 
+func benchmarkConvertBigIntPow10(b *testing.B, exp int64) {
+	n := new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil)
+	n.Sub(n, big.NewInt(1)) // all nines, so no group is skipped
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ConvertBigInt(n)
+	}
+}
+
+func BenchmarkConvertBigInt_1e100(b *testing.B)   { benchmarkConvertBigIntPow10(b, 100) }
+func BenchmarkConvertBigInt_1e1000(b *testing.B)  { benchmarkConvertBigIntPow10(b, 1000) }
+func BenchmarkConvertBigInt_1e10000(b *testing.B) { benchmarkConvertBigIntPow10(b, 10000) }
+
 func BenchmarkConvertBigInt(b *testing.B) {
 	n := new(big.Int)
 	n.SetString("1000000000000000000000000000000000", 10) // decillion
@@ -1,30 +1,224 @@
 package num2persian
 
-import "strings"
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
 
-var specialOrdinals = map[int64]string{
-	1: "اول",
-	3: "سوم",
+// FirstOrdinal selects the ordinal words used for one.
+type FirstOrdinal int
+
+const (
+	FirstAvval   FirstOrdinal = iota // اول، اولین
+	FirstYekom                       // یکم، یکمین
+	FirstNokhost                     // نخست، نخستین
+)
+
+var firstOrdinals = []string{"اول", "یکم", "نخست"}
+
+// ErrInvalidOrdinal is returned by ConvertOrdinalChecked for values that have
+// no ordinal form: nil, zero and negative numbers.
+var ErrInvalidOrdinal = errors.New("num2persian: ordinal requires a positive number")
+
+// ordinalRules describes how a locale turns cardinal text into an ordinal by
+// inflecting its last word.
+type ordinalRules struct {
+	// endings lists the words whose ordinal form is irregular.
+	endings map[string]string
+	// suffix makes a word an ordinal; vowelSuffix replaces it after a word
+	// ending in one of vowels.
+	suffix, vowelSuffix, vowels string
+	// adjective turns an ordinal into its adjectival form.
+	adjective string
+}
+
+// persianOrdinals: "سه" becomes "سوم", words ending in "ی" take "ام" after a
+// ZWNJ (سی‌ام) and every other word takes "م". The adjectival form adds
+// "ین": دوم → دومین.
+var persianOrdinals = &ordinalRules{
+	endings:     map[string]string{"سه": "سوم"},
+	suffix:      "م",
+	vowelSuffix: zwnj + "ام",
+	vowels:      "ی",
+	adjective:   "ین",
+}
+
+// colloquialOrdinals restore the "ت" of "بیس" and the "ه" of "پنجا", as
+// the spoken ordinals are بیستم and پنجاهم.
+var colloquialOrdinals = func() *ordinalRules {
+	r := *persianOrdinals
+	r.endings = map[string]string{"سه": "سوم", "بیس": "بیستم", "پنجا": "پنجاهم"}
+	return &r
+}()
+
+// finglishOrdinals: words take "om" (chaharom, siom), except "do" and "se",
+// which become "dovom" and "sevom".
+var finglishOrdinals = &ordinalRules{
+	endings:   map[string]string{"do": "dovom", "se": "sevom"},
+	suffix:    "om",
+	adjective: "in",
+}
+
+// romanizedOrdinals follow the romanization of the Persian ordinals: words
+// take "um" (chahārum), "ʼum" after "ī" (sīʼum), and "dū" and "sih" become
+// "duvum" and "sivum".
+var romanizedOrdinals = &ordinalRules{
+	endings:     map[string]string{"dū": "duvum", "sih": "sivum"},
+	suffix:      "um",
+	vowelSuffix: "ʼum",
+	vowels:      "ī",
+	adjective:   "īn",
+}
+
+// tajikOrdinals: words take "ум" (чорум), or "юм" after a vowel (дуюм,
+// сеюм), and "сӣ" becomes "сиюм". The adjectival form adds "ин": дуюмин.
+var tajikOrdinals = &ordinalRules{
+	endings:     map[string]string{"сӣ": "сиюм"},
+	suffix:      "ум",
+	vowelSuffix: "юм",
+	vowels:      "аеиоуӯ",
+	adjective:   "ин",
 }
 
 // ConvertOrdinal converts an integer to Persian ordinal text.
 func ConvertOrdinal(n int64) string {
+	return defaultConverter.ConvertOrdinal(n)
+}
+
+// ConvertOrdinalInt converts an int to Persian ordinal text.
+func ConvertOrdinalInt(n int) string {
+	return defaultConverter.ConvertOrdinalInt(n)
+}
+
+// ConvertOrdinalBigInt converts a big.Int to Persian ordinal text.
+func ConvertOrdinalBigInt(n *big.Int) string {
+	return defaultConverter.ConvertOrdinalBigInt(n)
+}
+
+// ConvertOrdinalUint64 converts a uint64 to Persian ordinal text.
+func ConvertOrdinalUint64(n uint64) string {
+	return defaultConverter.ConvertOrdinalUint64(n)
+}
+
+// ConvertOrdinalChecked converts a big.Int to Persian ordinal text, returning
+// ErrInvalidOrdinal instead of an empty string for nil or n <= 0.
+func ConvertOrdinalChecked(n *big.Int) (string, error) {
+	return defaultConverter.ConvertOrdinalChecked(n)
+}
+
+// ConvertOrdinalAdjective converts an integer to the Persian ordinal form
+// used before a noun, e.g. "دومین" in "دومین جلسه". One is written "اولین".
+func ConvertOrdinalAdjective(n int64) string {
+	return defaultConverter.ConvertOrdinalAdjective(n)
+}
+
+// ConvertOrdinalAdjectiveInt converts an int to the Persian ordinal form used
+// before a noun.
+func ConvertOrdinalAdjectiveInt(n int) string {
+	return defaultConverter.ConvertOrdinalAdjectiveInt(n)
+}
+
+// ConvertOrdinalAdjectiveBigInt converts a big.Int to the Persian ordinal
+// form used before a noun.
+func ConvertOrdinalAdjectiveBigInt(n *big.Int) string {
+	return defaultConverter.ConvertOrdinalAdjectiveBigInt(n)
+}
+
+// ConvertOrdinal converts an integer to ordinal text. It returns an empty
+// string for n <= 0.
+func (c *Converter) ConvertOrdinal(n int64) string {
 	if n <= 0 {
 		return ""
 	}
+	if n == 1 {
+		return c.firstOrdinals[c.firstOrdinal]
+	}
+	return c.ordinalSuffix(c.Convert(n))
+}
+
+// ConvertOrdinalInt converts an int to ordinal text.
+func (c *Converter) ConvertOrdinalInt(n int) string {
+	return c.ConvertOrdinal(int64(n))
+}
 
-	if special, ok := specialOrdinals[n]; ok {
-		return special
+// ConvertOrdinalBigInt converts a big.Int to ordinal text. It returns an
+// empty string for nil or n <= 0.
+func (c *Converter) ConvertOrdinalBigInt(n *big.Int) string {
+	if n == nil || n.Sign() <= 0 {
+		return ""
 	}
+	if n.IsInt64() {
+		return c.ConvertOrdinal(n.Int64())
+	}
+	return c.ordinalSuffix(c.convertBigIntPositive(n))
+}
 
-	cardinal := Convert(n)
-	if trimmed := strings.TrimSuffix(cardinal, "سه"); trimmed != cardinal {
-		return trimmed + "سوم"
+// ConvertOrdinalUint64 converts a uint64 to ordinal text. It returns an empty
+// string for zero.
+func (c *Converter) ConvertOrdinalUint64(n uint64) string {
+	switch n {
+	case 0:
+		return ""
+	case 1:
+		return c.firstOrdinals[c.firstOrdinal]
 	}
-	return cardinal + "م"
+	return c.ordinalSuffix(c.convertPositive(n))
 }
 
-// ConvertOrdinalInt converts an int to Persian ordinal text.
-func ConvertOrdinalInt(n int) string {
-	return ConvertOrdinal(int64(n))
+// ConvertOrdinalChecked converts a big.Int to ordinal text, returning
+// ErrInvalidOrdinal for nil or n <= 0.
+func (c *Converter) ConvertOrdinalChecked(n *big.Int) (string, error) {
+	if n == nil || n.Sign() <= 0 {
+		return "", ErrInvalidOrdinal
+	}
+	return c.ConvertOrdinalBigInt(n), nil
+}
+
+// ConvertOrdinalAdjective converts an integer to the ordinal form used before
+// a noun. It returns an empty string for n <= 0.
+func (c *Converter) ConvertOrdinalAdjective(n int64) string {
+	if n <= 0 {
+		return ""
+	}
+	return c.ConvertOrdinal(n) + c.ordinals.adjective
+}
+
+// ConvertOrdinalAdjectiveInt converts an int to the ordinal form used before
+// a noun.
+func (c *Converter) ConvertOrdinalAdjectiveInt(n int) string {
+	return c.ConvertOrdinalAdjective(int64(n))
+}
+
+// ConvertOrdinalAdjectiveBigInt converts a big.Int to the ordinal form used
+// before a noun. It returns an empty string for nil or n <= 0.
+func (c *Converter) ConvertOrdinalAdjectiveBigInt(n *big.Int) string {
+	ordinal := c.ConvertOrdinalBigInt(n)
+	if ordinal == "" {
+		return ""
+	}
+	return ordinal + c.ordinals.adjective
+}
+
+// ordinalSuffix turns cardinal text into its ordinal form by inflecting the
+// last word.
+func (c *Converter) ordinalSuffix(cardinal string) string {
+	return string(c.appendOrdinalSuffix([]byte(cardinal), 0))
+}
+
+// appendOrdinalSuffix turns the cardinal words in dst[start:] into their
+// ordinal form by inflecting the last word.
+func (c *Converter) appendOrdinalSuffix(dst []byte, start int) []byte {
+	i := start + bytes.LastIndexByte(dst[start:], ' ') + 1
+	last := dst[i:]
+
+	if ordinal, ok := c.ordinals.endings[string(last)]; ok {
+		return append(dst[:i], ordinal...)
+	}
+	if r, _ := utf8.DecodeLastRune(last); strings.ContainsRune(c.ordinals.vowels, r) {
+		return append(dst, c.ordinals.vowelSuffix...)
+	}
+	return append(dst, c.ordinals.suffix...)
 }
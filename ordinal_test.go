@@ -1,6 +1,11 @@
 package num2persian
 
-import "testing"
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
 
 func TestConvertOrdinal(t *testing.T) {
 	tests := []struct {
@@ -19,6 +24,11 @@ func TestConvertOrdinal(t *testing.T) {
 		{100, "صدم"},
 		{103, "صد و سوم"},
 		{1000, "هزارم"},
+		{30, "سی‌ام"},
+		{33, "سی و سوم"},
+		{130, "صد و سی‌ام"},
+		{1030, "هزار و سی‌ام"},
+		{1000000, "یک میلیونم"},
 	}
 
 	for _, tt := range tests {
@@ -52,3 +62,196 @@ func TestConvertOrdinalInt(t *testing.T) {
 		t.Errorf("ConvertOrdinalInt(5) = %q, want %q", result, expected)
 	}
 }
+
+func TestConvertOrdinal_Exhaustive(t *testing.T) {
+	// The ordinal form of every word that can end a cardinal up to 10000.
+	endings := map[string]string{
+		"یک": "یکم", "دو": "دوم", "سه": "سوم", "چهار": "چهارم", "پنج": "پنجم",
+		"شش": "ششم", "هفت": "هفتم", "هشت": "هشتم", "نه": "نهم",
+		"ده": "دهم", "یازده": "یازدهم", "دوازده": "دوازدهم", "سیزده": "سیزدهم",
+		"چهارده": "چهاردهم", "پانزده": "پانزدهم", "شانزده": "شانزدهم",
+		"هفده": "هفدهم", "هجده": "هجدهم", "نوزده": "نوزدهم",
+		"بیست": "بیستم", "سی": "سی‌ام", "چهل": "چهلم", "پنجاه": "پنجاهم",
+		"شصت": "شصتم", "هفتاد": "هفتادم", "هشتاد": "هشتادم", "نود": "نودم",
+		"صد": "صدم", "دویست": "دویستم", "سیصد": "سیصدم", "چهارصد": "چهارصدم",
+		"پانصد": "پانصدم", "ششصد": "ششصدم", "هفتصد": "هفتصدم",
+		"هشتصد": "هشتصدم", "نهصد": "نهصدم",
+		"هزار": "هزارم",
+	}
+
+	for n := int64(2); n <= 10000; n++ {
+		cardinal := Convert(n)
+		i := strings.LastIndex(cardinal, " ") + 1
+		ending, ok := endings[cardinal[i:]]
+		if !ok {
+			t.Fatalf("no ordinal ending for %q in %q", cardinal[i:], cardinal)
+		}
+		expected := cardinal[:i] + ending
+		if result := ConvertOrdinal(n); result != expected {
+			t.Errorf("ConvertOrdinal(%d) = %q, want %q", n, result, expected)
+		}
+	}
+}
+
+func TestConvertOrdinalAdjective(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{1, "اولین"},
+		{2, "دومین"},
+		{3, "سومین"},
+		{10, "دهمین"},
+		{21, "بیست و یکمین"},
+		{23, "بیست و سومین"},
+		{30, "سی‌امین"},
+		{100, "صدمین"},
+		{1000, "هزارمین"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := ConvertOrdinalAdjective(tt.input)
+			if result != tt.expected {
+				t.Errorf("ConvertOrdinalAdjective(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertOrdinalAdjective_NonPositive(t *testing.T) {
+	for _, n := range []int64{0, -1} {
+		if result := ConvertOrdinalAdjective(n); result != "" {
+			t.Errorf("ConvertOrdinalAdjective(%d) = %q, want empty string", n, result)
+		}
+	}
+}
+
+func TestConvertOrdinalAdjectiveInt(t *testing.T) {
+	result := ConvertOrdinalAdjectiveInt(5)
+	expected := "پنجمین"
+	if result != expected {
+		t.Errorf("ConvertOrdinalAdjectiveInt(5) = %q, want %q", result, expected)
+	}
+}
+
+func TestConvertOrdinalAdjectiveBigInt(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1", "اولین"},
+		{"33", "سی و سومین"},
+		{"1000000000000000000000", "یک سکستیلیونمین"},
+		{"0", ""},
+		{"-5", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, _ := new(big.Int).SetString(tt.input, 10)
+			result := ConvertOrdinalAdjectiveBigInt(n)
+			if result != tt.expected {
+				t.Errorf("ConvertOrdinalAdjectiveBigInt(%s) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	if result := ConvertOrdinalAdjectiveBigInt(nil); result != "" {
+		t.Errorf("ConvertOrdinalAdjectiveBigInt(nil) = %q, want empty string", result)
+	}
+}
+
+func TestConverter_FirstOrdinal(t *testing.T) {
+	tests := []struct {
+		first     FirstOrdinal
+		ordinal   string
+		adjective string
+	}{
+		{FirstAvval, "اول", "اولین"},
+		{FirstYekom, "یکم", "یکمین"},
+		{FirstNokhost, "نخست", "نخستین"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.adjective, func(t *testing.T) {
+			c := NewConverter(Options{FirstOrdinal: tt.first})
+			if result := c.ConvertOrdinal(1); result != tt.ordinal {
+				t.Errorf("ConvertOrdinal(1) = %q, want %q", result, tt.ordinal)
+			}
+			if result := c.ConvertOrdinalAdjective(1); result != tt.adjective {
+				t.Errorf("ConvertOrdinalAdjective(1) = %q, want %q", result, tt.adjective)
+			}
+			if result := c.ConvertOrdinalAdjective(21); result != "بیست و یکمین" {
+				t.Errorf("ConvertOrdinalAdjective(21) = %q, want %q", result, "بیست و یکمین")
+			}
+		})
+	}
+}
+
+func TestConvertOrdinalBigInt(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1", "اول"},
+		{"30", "سی‌ام"},
+		{"9223372036854775808", "نه کوینتیلیون و دویست و بیست و سه کوادریلیون و سیصد و هفتاد و دو تریلیون و سی و شش میلیارد و هشتصد و پنجاه و چهار میلیون و هفتصد و هفتاد و پنج هزار و هشتصد و هشتم"},
+		{"1000000000000000000003", "یک سکستیلیون و سوم"},
+		{"0", ""},
+		{"-1", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, _ := new(big.Int).SetString(tt.input, 10)
+			result := ConvertOrdinalBigInt(n)
+			if result != tt.expected {
+				t.Errorf("ConvertOrdinalBigInt(%s) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	if result := ConvertOrdinalBigInt(nil); result != "" {
+		t.Errorf("ConvertOrdinalBigInt(nil) = %q, want empty string", result)
+	}
+}
+
+func TestConvertOrdinalUint64(t *testing.T) {
+	tests := []struct {
+		input    uint64
+		expected string
+	}{
+		{0, ""},
+		{1, "اول"},
+		{3, "سوم"},
+		{30, "سی‌ام"},
+		{18446744073709551615, "هجده کوینتیلیون و چهارصد و چهل و شش کوادریلیون و هفتصد و چهل و چهار تریلیون و هفتاد و سه میلیارد و هفتصد و نه میلیون و پانصد و پنجاه و یک هزار و ششصد و پانزدهم"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			result := ConvertOrdinalUint64(tt.input)
+			if result != tt.expected {
+				t.Errorf("ConvertOrdinalUint64(%d) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestConvertOrdinalChecked(t *testing.T) {
+	result, err := ConvertOrdinalChecked(big.NewInt(2))
+	if err != nil || result != "دوم" {
+		t.Errorf("ConvertOrdinalChecked(2) = %q, %v, want %q, nil", result, err, "دوم")
+	}
+
+	for _, n := range []*big.Int{nil, big.NewInt(0), big.NewInt(-3)} {
+		result, err := ConvertOrdinalChecked(n)
+		if !errors.Is(err, ErrInvalidOrdinal) {
+			t.Errorf("ConvertOrdinalChecked(%v) error = %v, want ErrInvalidOrdinal", n, err)
+		}
+		if result != "" {
+			t.Errorf("ConvertOrdinalChecked(%v) = %q, want empty string", n, result)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package num2persian
+
+import (
+	"math/big"
+	"strings"
+)
+
+type wordKind int
+
+const (
+	kindOnes wordKind = iota
+	kindTeens
+	kindTens
+	kindHundreds
+	kindScale
+)
+
+// wordInfo describes a vocabulary word. For scale words value is the scale
+// index, for every other kind it is the numeric value of the word.
+type wordInfo struct {
+	kind  wordKind
+	value int
+}
+
+// rank orders the components of a three-digit group: a component may only be
+// followed by components of a lower rank. Teens rank with tens, so neither
+// may follow the other.
+func (w wordInfo) rank() int {
+	switch w.kind {
+	case kindHundreds:
+		return 3
+	case kindTens, kindTeens:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func (c *Converter) buildWordTable() map[string]wordInfo {
+	m := make(map[string]wordInfo)
+	for i, w := range c.ones {
+		if w != "" {
+			m[w] = wordInfo{kindOnes, i}
+		}
+	}
+	for i, w := range c.teens {
+		m[w] = wordInfo{kindTeens, 10 + i}
+	}
+	for i, w := range c.tens {
+		if w != "" {
+			m[w] = wordInfo{kindTens, i * 10}
+			m[c.finalTens[i]] = wordInfo{kindTens, i * 10}
+		}
+	}
+	for i, w := range c.hundreds {
+		if w != "" {
+			m[w] = wordInfo{kindHundreds, i * 100}
+		}
+	}
+	m[c.scaleOne] = wordInfo{kindOnes, 1}
+	for i, w := range c.scales {
+		if w != "" {
+			m[w] = wordInfo{kindScale, i}
+		}
+	}
+	return m
+}
+
+// lookup returns the vocabulary word tok, reading generated scale names
+// beyond the table.
+func (c *Converter) lookup(tok string) (wordInfo, bool) {
+	if info, ok := c.words[tok]; ok {
+		return info, true
+	}
+	if c.names != nil {
+		if index, ok := c.names.parseScale(tok, c.scaleSystem); ok && index >= len(c.scales) {
+			return wordInfo{kindScale, index}, true
+		}
+	}
+	return wordInfo{}, false
+}
+
+// Parse converts Persian number words, as produced by Convert, back to an int64.
+func Parse(s string) (int64, error) {
+	return defaultConverter.Parse(s)
+}
+
+// MaxParseDigits is the largest number of decimal digits ParseBigInt reads.
+// Larger numbers, and scale names beyond 1000^3333, are rejected before any
+// arithmetic is done, so that short input cannot cost a long computation.
+const MaxParseDigits = 10000
+
+// ParseBigInt converts Persian number words, as produced by ConvertBigInt,
+// back to a big.Int. Generated scale names such as "آندسیلیون" and numbers
+// written with stacked scales, such as "هزار دسیلیون", are accepted, up to
+// MaxParseDigits digits.
+func ParseBigInt(s string) (*big.Int, error) {
+	return defaultConverter.ParseBigInt(s)
+}
+
+// Parse converts number words in the Converter's vocabulary back to an
+// int64. Words must be separated by whitespace, with the Separator read as a
+// word of its own.
+func (c *Converter) Parse(s string) (int64, error) {
+	n, err := c.parseWords(s, int64Limit)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsInt64() {
+		return 0, &ParseError{Input: s, Reason: "value out of int64 range"}
+	}
+	return n.Int64(), nil
+}
+
+// ParseBigInt converts number words in the Converter's vocabulary back to a
+// big.Int of up to MaxParseDigits digits.
+func (c *Converter) ParseBigInt(s string) (*big.Int, error) {
+	return c.parseWords(s, bigIntLimit)
+}
+
+// parseLimit bounds the numbers read by parseWords.
+type parseLimit struct {
+	// maxScale is the largest scale index read.
+	maxScale int
+	// maxDigits is the largest number of digits in the result.
+	maxDigits int
+	// reason is the error reason for a number beyond the limit.
+	reason string
+}
+
+var (
+	int64Limit  = parseLimit{maxGroups - 1, 19, "value out of int64 range"}
+	bigIntLimit = parseLimit{(MaxParseDigits - 1) / 3, MaxParseDigits, "value beyond MaxParseDigits digits"}
+)
+
+// exceeds reports whether n has more digits than the limit allows. Bit
+// lengths rule out most values before the digits are counted.
+func (l parseLimit) exceeds(n *big.Int) bool {
+	bits := n.BitLen()
+	switch {
+	case bits <= l.maxDigits*3:
+		return false
+	case bits > l.maxDigits*4:
+		return true
+	}
+	return len(new(big.Int).Abs(n).Text(10)) > l.maxDigits
+}
+
+type tokenState int
+
+const (
+	stateStart tokenState = iota
+	stateComponent
+	stateScale
+	stateConnector
+)
+
+// parseWords reads s as number words. Scale indexes beyond the limit are
+// rejected before any power is computed, and the total is checked against the
+// limit whenever stacked scales multiply it.
+func (c *Converter) parseWords(s string, limit parseLimit) (*big.Int, error) {
+	fail := func(reason string) (*big.Int, error) {
+		return nil, &ParseError{Input: s, Reason: reason}
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return fail("empty input")
+	}
+
+	isNegative := fields[0] == c.negative
+	if isNegative {
+		fields = fields[1:]
+		if len(fields) == 0 {
+			return fail("missing number after " + quote(c.negative))
+		}
+	}
+	if len(fields) == 1 && fields[0] == c.zero {
+		if isNegative {
+			return fail(quote(c.negative) + " cannot precede " + quote(c.zero))
+		}
+		return new(big.Int), nil
+	}
+
+	connector := c.connector()
+	total := new(big.Int)
+	group, rank, lastScale := 0, 0, -1
+	state := stateStart
+	prev := ""
+
+	for i := 0; i < len(fields); i++ {
+		tok := fields[i]
+		if tok == connector {
+			if state == stateStart || state == stateConnector {
+				return fail("unexpected " + quote(connector))
+			}
+			state = stateConnector
+			prev = tok
+			continue
+		}
+		if tok == c.zero {
+			return fail(quote(c.zero) + " cannot be combined with other words")
+		}
+
+		info, ok := c.lookup(tok)
+		// A vocabulary word may span two fields, as "یک صد" does in Dari.
+		if i+1 < len(fields) {
+			if pair, found := c.words[tok+" "+fields[i+1]]; found {
+				tok += " " + fields[i+1]
+				info, ok = pair, true
+				i++
+			}
+		}
+		if !ok {
+			return fail("unknown word " + quote(tok))
+		}
+		if info.kind == kindScale && info.value > limit.maxScale {
+			return fail(limit.reason)
+		}
+
+		if info.kind == kindScale && info.value == len(c.scales)-1 && lastScale <= info.value {
+			// Unless a generated scale came before it, the largest scale of
+			// the table multiplies everything read so far, which is how
+			// stacked scales write numbers beyond the table.
+			switch {
+			case state == stateConnector && group != 0:
+				return fail("scale " + quote(tok) + " cannot follow " + quote(connector))
+			case group == 0 && state != stateScale:
+				return fail("missing number before " + quote(tok))
+			}
+			total.Add(total, big.NewInt(int64(group)))
+			total.Mul(total, scaleValue(info.value))
+			if limit.exceeds(total) {
+				return fail(limit.reason)
+			}
+			group, rank, lastScale = 0, 0, info.value
+			state = stateScale
+			prev = tok
+			continue
+		}
+
+		if info.kind == kindScale {
+			switch {
+			case state == stateScale:
+				return fail("scale " + quote(tok) + " cannot follow scale " + quote(prev))
+			case group == 0 && info.value != 1:
+				return fail("missing number before " + quote(tok))
+			case state == stateConnector && group != 0:
+				return fail("scale " + quote(tok) + " cannot follow " + quote(connector))
+			case lastScale == info.value:
+				return fail("repeated scale " + quote(tok))
+			case lastScale != -1 && info.value > lastScale:
+				return fail("scale " + quote(tok) + " out of order after " + quote(c.scaleName(lastScale)))
+			}
+			if group == 0 {
+				group = 1
+			}
+			total.Add(total, new(big.Int).Mul(big.NewInt(int64(group)), scaleValue(info.value)))
+			group, rank, lastScale = 0, 0, info.value
+			state = stateScale
+			prev = tok
+			continue
+		}
+
+		switch state {
+		case stateComponent:
+			return fail("missing " + quote(connector) + " between " + quote(prev) + " and " + quote(tok))
+		case stateScale:
+			return fail("missing " + quote(connector) + " after " + quote(prev))
+		}
+		if group != 0 && info.rank() >= rank {
+			return fail(quote(tok) + " out of order in group")
+		}
+		group += info.value
+		rank = info.rank()
+		if info.kind == kindTeens {
+			// Nothing follows a teen in its group.
+			rank = 1
+		}
+		state = stateComponent
+		prev = tok
+	}
+
+	if state == stateConnector {
+		return fail("trailing " + quote(connector))
+	}
+	total.Add(total, big.NewInt(int64(group)))
+	if limit.exceeds(total) {
+		return fail(limit.reason)
+	}
+	if isNegative {
+		total.Neg(total)
+	}
+	return total, nil
+}
+
+// scaleValue returns 1000^index.
+func scaleValue(index int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(1000), big.NewInt(int64(index)), nil)
+}
+
+func quote(s string) string {
+	return "\"" + s + "\""
+}
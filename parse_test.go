@@ -0,0 +1,220 @@
+package num2persian
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+	}{
+		{"صفر", 0},
+		{"یک", 1},
+		{"ده", 10},
+		{"نوزده", 19},
+		{"بیست و یک", 21},
+		{"صد", 100},
+		{"صد و یازده", 111},
+		{"نهصد و نود و نه", 999},
+		{"هزار", 1000},
+		{"یک هزار", 1000},
+		{"هزار و یک", 1001},
+		{"دو هزار", 2000},
+		{"یک میلیون و هزار", 1001000},
+		{"یک میلیون و پانصد هزار", 1500000},
+		{"منفی پانصد", -500},
+		{"  صد   و  بیست  ", 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Parse(%q) = %d, want %d", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	inputs := []int64{math.MaxInt64, math.MinInt64, 1234567, -999999, 1000000000000}
+	for i := int64(0); i <= 20000; i++ {
+		inputs = append(inputs, i)
+	}
+
+	for _, n := range inputs {
+		text := Convert(n)
+		result, err := Parse(text)
+		if err != nil {
+			t.Fatalf("Parse(Convert(%d)) unexpected error: %v", n, err)
+		}
+		if result != n {
+			t.Fatalf("Parse(%q) = %d, want %d", text, result, n)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		input  string
+		reason string
+	}{
+		{"", "empty input"},
+		{"منفی", `missing number after "منفی"`},
+		{"منفی صفر", `"منفی" cannot precede "صفر"`},
+		{"صفر و یک", `"صفر" cannot be combined with other words`},
+		{"یک و", `trailing "و"`},
+		{"و یک", `unexpected "و"`},
+		{"سیب", `unknown word "سیب"`},
+		{"صد بیست", `missing "و" between "صد" and "بیست"`},
+		{"هزار یک", `missing "و" after "هزار"`},
+		{"بیست و صد", `"صد" out of order in group`},
+		{"ده و یک", `"یک" out of order in group`},
+		{"بیست و ده", `"ده" out of order in group`},
+		{"بیست و یازده", `"یازده" out of order in group`},
+		{"میلیون", `missing number before "میلیون"`},
+		{"صد و هزار", `scale "هزار" cannot follow "و"`},
+		{"دو هزار و سه هزار", `repeated scale "هزار"`},
+		{"دو هزار و سه میلیون", `scale "میلیون" out of order after "هزار"`},
+		{"ده کوینتیلیون", "value out of int64 range"},
+		{"یک سکستیلیون", "value out of int64 range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse(%q) error = %v, want *ParseError", tt.input, err)
+			}
+			if perr.Reason != tt.reason {
+				t.Errorf("Parse(%q) reason = %q, want %q", tt.input, perr.Reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestParseError_Reason(t *testing.T) {
+	err := &ParseError{Input: "test", Reason: "empty input"}
+	expected := `num2persian: cannot parse "test" as a number: empty input`
+	if err.Error() != expected {
+		t.Errorf("ParseError.Error() = %q, want %q", err.Error(), expected)
+	}
+}
+
+func TestParseBigInt(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"صفر", "0"},
+		{"یک دسیلیون", "1000000000000000000000000000000000"},
+		{"یک دسیلیون و یک نونیلیون", "1001000000000000000000000000000000"},
+		{"هزار دسیلیون", "1000000000000000000000000000000000000"},
+		{"یک دسیلیون دسیلیون", "1000000000000000000000000000000000000000000000000000000000000000000"},
+		{"یک آندسیلیون", "1000000000000000000000000000000000000"},
+		{"یک آنویجینتیلیون", "1000000000000000000000000000000000000000000000000000000000000000000"},
+		{"دو آندسیلیون و سه دسیلیون", "2003000000000000000000000000000000000"},
+		{"منفی ده کوینتیلیون", "-10000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseBigInt(tt.input)
+			if err != nil {
+				t.Fatalf("ParseBigInt(%q) unexpected error: %v", tt.input, err)
+			}
+			if result.String() != tt.expected {
+				t.Errorf("ParseBigInt(%q) = %s, want %s", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseBigInt_RoundTrip(t *testing.T) {
+	inputs := []string{
+		"9223372036854775808",
+		"-18446744073709551615",
+		"1000000000000000000000000000000000000",
+		"123456789012345678901234567890123456789",
+		"1000000000000000000000000000001000000000000000000000000000000000002",
+		"999999999999999999999999999999999999999999999999999999999999999999999999999999",
+	}
+
+	for _, input := range inputs {
+		n, _ := new(big.Int).SetString(input, 10)
+		text := ConvertBigInt(n)
+		result, err := ParseBigInt(text)
+		if err != nil {
+			t.Fatalf("ParseBigInt(%q) unexpected error: %v", text, err)
+		}
+		if result.Cmp(n) != 0 {
+			t.Errorf("ParseBigInt(%q) = %s, want %s", text, result, input)
+		}
+	}
+}
+
+func TestParseBigInt_Errors(t *testing.T) {
+	tests := []struct {
+		input  string
+		reason string
+	}{
+		{"دسیلیون", `missing number before "دسیلیون"`},
+		{"صد و دسیلیون", `scale "دسیلیون" cannot follow "و"`},
+		{"هزار میلیون", `scale "میلیون" cannot follow scale "هزار"`},
+		{"یک دسیلیون و دو آندسیلیون", `scale "آندسیلیون" out of order after "دسیلیون"`},
+		{"یک بیلیون", `unknown word "بیلیون"`},
+		{"یک نیلیمیلیون", `unknown word "نیلیمیلیون"`},
+		{"یک " + defaultConverter.scaleName(3334), "value beyond MaxParseDigits digits"},
+		{"یک" + strings.Repeat(" دسیلیون", 310), "value beyond MaxParseDigits digits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseBigInt(tt.input)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseBigInt(%q) error = %v, want *ParseError", tt.input, err)
+			}
+			if perr.Reason != tt.reason {
+				t.Errorf("ParseBigInt(%q) reason = %q, want %q", tt.input, perr.Reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestParse_LargeScaleLimit(t *testing.T) {
+	// Forty of the longest generated names: reading them must be rejected
+	// before 1000^index is computed.
+	word := defaultConverter.scaleName(999999)
+	input := strings.TrimSpace(strings.Repeat("یک "+word+" ", 40))
+
+	start := time.Now()
+	if _, err := Parse(input); err == nil {
+		t.Error("Parse accepted a scale beyond int64")
+	}
+	if _, err := ParseBigInt(input); err == nil {
+		t.Error("ParseBigInt accepted a scale beyond MaxParseDigits")
+	}
+	if _, _, err := ParseLenient(input); err == nil {
+		t.Error("ParseLenient accepted a scale beyond int64")
+	}
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Errorf("rejecting %d bytes took %v", len(input), elapsed)
+	}
+
+	largest := new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(MaxParseDigits), nil), big.NewInt(1))
+	result, err := ParseBigInt(ConvertBigInt(largest))
+	if err != nil || result.Cmp(largest) != 0 {
+		t.Errorf("ParseBigInt(ConvertBigInt(10^MaxParseDigits-1)) = %v, %v", result != nil, err)
+	}
+}
@@ -0,0 +1,334 @@
+package num2persian
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// ScaleSystem selects how powers of 1000 are named.
+type ScaleSystem int
+
+const (
+	// IranianScale is the short scale with میلیارد for 10^9, as used in Iran:
+	// میلیون، میلیارد، تریلیون، کوادریلیون، ...
+	IranianScale ScaleSystem = iota
+	// ShortScale names every power of 1000 from 10^6 on with "-یلیون":
+	// میلیون، بیلیون، تریلیون، ...
+	ShortScale
+	// LongScale names the powers of a million with "-یلیون" and the powers in
+	// between with "-یلیارد", as in Afghanistan and much of Europe:
+	// میلیون، میلیارد، بیلیون، بیلیارد، تریلیون، ...
+	LongScale
+)
+
+// Scale names beyond the scales table are generated with the Conway–Wechsler
+// system, transliterated morpheme by morpheme. In the short scale the name of
+// 1000^(m+1) is built from m, so that m = 1 is میلیون, m = 10 is دسیلیون and
+// m = 11 is آندسیلیون. In the long scale the same name is used for 10^(6m),
+// and 10^(6m+3) ends in یلیارد instead of یلیون. The name of m is built as
+// follows:
+//
+//   - m from 1 to 9 uses a stem: م، ب، تر، کوادر، کوینت، سکست، سپت، اکت، نون.
+//   - A larger m is written as units, tens and hundreds morphemes in that
+//     order: 21 is آن + ویجینتی, 103 is ترس + سنتی.
+//   - Some units change before tens and hundreds carrying a mark: تره
+//     becomes ترس before s or x, س becomes سس before s and سکس before x,
+//     سپته and نووه end in م before m and in ن before n, and دو becomes دوئو
+//     before سنتی so that 102 is not read as 200 (دوسنتی).
+//   - An m of 1000 or more is split into three-digit groups, each named as
+//     above, with ن for an empty group, joined by یلی: 1000 is میلینیلیون.
+//   - The final vowel (ی or ا) of each group is dropped before یلی and
+//     before the closing یلیون: دسی + یلیون is دسیلیون.
+//
+// The generated names agree with the scales table wherever the table follows
+// the short scale, which is checked by the tests.
+
+// Marks carried by the tens and hundreds morphemes, which select the form of
+// a preceding unit. They are the same for every transliteration. The c mark
+// is not part of Conway–Wechsler: centi carries it so that a transliteration
+// can keep duo + centi apart from ducenti where the two would be spelled
+// alike.
+var (
+	tensMarks     = [10]string{"", "n", "ms", "ns", "ns", "ns", "n", "n", "mx", ""}
+	hundredsMarks = [10]string{"", "nxc", "n", "ns", "ns", "ns", "n", "n", "mx", ""}
+)
+
+// scaleMorphemes is a transliteration of the Conway–Wechsler morphemes.
+type scaleMorphemes struct {
+	stems    [10]string
+	units    [10]string
+	tens     [10]string
+	hundreds [10]string
+	// marked gives the form of a unit before a morpheme carrying the mark,
+	// where it differs from units.
+	marked map[byte][10]string
+	// zero names an empty group, link joins groups and suffix ends the name;
+	// longSuffix ends the names of the odd powers of 1000 in the long scale.
+	zero, link, suffix, longSuffix string
+	// vowels lists the final letters dropped before link and suffix.
+	vowels string
+}
+
+var persianScaleMorphemes = scaleMorphemes{
+	stems: [10]string{"", "م", "ب", "تر", "کوادر", "کوینت", "سکست", "سپت", "اکت", "نون"},
+	units: [10]string{"", "آن", "دو", "تره", "کواتور", "کوینکوا", "س", "سپته", "اکتو", "نووه"},
+	tens: [10]string{"", "دسی", "ویجینتی", "تریجینتا", "کوادراجینتا", "کوینکواجینتا",
+		"سکساجینتا", "سپتواجینتا", "اکتوجینتا", "نوناجینتا"},
+	hundreds: [10]string{"", "سنتی", "دوسنتی", "ترسنتی", "کوادرینجنتی", "کوینجنتی",
+		"سسنتی", "سپتینجنتی", "اکتینجنتی", "نونجنتی"},
+	marked: map[byte][10]string{
+		's': {3: "ترس", 6: "سس"},
+		'x': {3: "ترس", 6: "سکس"},
+		'm': {7: "سپتم", 9: "نووم"},
+		'n': {7: "سپتن", 9: "نوون"},
+		'c': {2: "دوئو"},
+	},
+	zero:       "ن",
+	link:       "یلی",
+	suffix:     "یلیون",
+	longSuffix: "یلیارد",
+	vowels:     "یا",
+}
+
+// maxScaleGroups bounds the three-digit groups of m accepted when reading a
+// generated name. The parser further rejects scales beyond MaxParseDigits
+// before computing their value.
+const maxScaleGroups = 2
+
+// scaleNamer generates and reads scale names from a set of morphemes.
+type scaleNamer struct {
+	morphemes scaleMorphemes
+	// groupNames names every group from 0 to 999 with its final vowel
+	// dropped, and groups maps those names back to their values.
+	groupNames [1000]string
+	groups     map[string]int
+}
+
+// dariScaleMorphemes drop the ی of یلیون and یلی, as in ملیون.
+var dariScaleMorphemes = func() scaleMorphemes {
+	m := persianScaleMorphemes
+	m.link = "لی"
+	m.suffix = "لیون"
+	m.longSuffix = "لیارد"
+	return m
+}()
+
+// tajikScaleMorphemes follow the Latin spelling, as in Russian: миллион,
+// миллиард, триллион, ..., дециллион, ундециллион.
+var tajikScaleMorphemes = scaleMorphemes{
+	stems: [10]string{"", "м", "б", "тр", "квадр", "квинт", "секст", "септ", "окт", "нон"},
+	units: [10]string{"", "ун", "дуо", "тре", "кваттуор", "квинква", "се", "септе", "окто", "нове"},
+	tens: [10]string{"", "деци", "вигинти", "тригинта", "квадрагинта", "квинквагинта",
+		"сексагинта", "септуагинта", "октогинта", "нонагинта"},
+	hundreds: [10]string{"", "центи", "дуценти", "треценти", "квадрингенти", "квингенти",
+		"сесценти", "септингенти", "октингенти", "нонгенти"},
+	marked: map[byte][10]string{
+		's': {3: "трес", 6: "сес"},
+		'x': {3: "трес", 6: "секс"},
+		'm': {7: "септем", 9: "новем"},
+		'n': {7: "септен", 9: "новен"},
+	},
+	zero:       "н",
+	link:       "илли",
+	suffix:     "иллион",
+	longSuffix: "иллиард",
+	vowels:     "иа",
+}
+
+// latinScaleMorphemes are the Conway–Wechsler morphemes in their English
+// spelling, used by Finglish: million, milliard, ..., decillion, undecillion.
+var latinScaleMorphemes = scaleMorphemes{
+	stems: [10]string{"", "m", "b", "tr", "quadr", "quint", "sext", "sept", "oct", "non"},
+	units: [10]string{"", "un", "duo", "tre", "quattuor", "quinqua", "se", "septe", "octo", "nove"},
+	tens: [10]string{"", "deci", "viginti", "triginta", "quadraginta", "quinquaginta",
+		"sexaginta", "septuaginta", "octoginta", "nonaginta"},
+	hundreds: [10]string{"", "centi", "ducenti", "trecenti", "quadringenti", "quingenti",
+		"sescenti", "septingenti", "octingenti", "nongenti"},
+	marked: map[byte][10]string{
+		's': {3: "tres", 6: "ses"},
+		'x': {3: "tres", 6: "sex"},
+		'm': {7: "septem", 9: "novem"},
+		'n': {7: "septen", 9: "noven"},
+	},
+	zero:       "n",
+	link:       "illi",
+	suffix:     "illion",
+	longSuffix: "illiard",
+	vowels:     "ia",
+}
+
+// romanizedScaleMorphemes spell the endings as the romanization of the
+// Persian names does: mīliyūn, mīliyārd, trīliyūn.
+var romanizedScaleMorphemes = func() scaleMorphemes {
+	m := latinScaleMorphemes
+	m.link = "īlī"
+	m.suffix = "īliyūn"
+	m.longSuffix = "īliyārd"
+	return m
+}()
+
+var (
+	latinScaleNamer     = newScaleNamer(latinScaleMorphemes)
+	romanizedScaleNamer = newScaleNamer(romanizedScaleMorphemes)
+	persianScaleNamer   = newScaleNamer(persianScaleMorphemes)
+	dariScaleNamer      = newScaleNamer(dariScaleMorphemes)
+	tajikScaleNamer     = newScaleNamer(tajikScaleMorphemes)
+)
+
+func newScaleNamer(m scaleMorphemes) *scaleNamer {
+	s := &scaleNamer{morphemes: m, groups: make(map[string]int, 1000)}
+	for g := range s.groupNames {
+		s.groupNames[g] = s.groupName(g)
+		s.groups[s.groupNames[g]] = g
+	}
+	return s
+}
+
+// appendScaleName appends the name of 1000^index for index >= 2.
+func (s *scaleNamer) appendScaleName(dst []byte, index int, system ScaleSystem) []byte {
+	switch {
+	case system == LongScale && index%2 == 1:
+		return s.appendName(dst, index/2, s.morphemes.longSuffix)
+	case system == LongScale:
+		return s.appendName(dst, index/2, s.morphemes.suffix)
+	case system == IranianScale && index == 3:
+		// 10^9 takes its long-scale name: میلیارد.
+		return s.appendName(dst, 1, s.morphemes.longSuffix)
+	}
+	return s.appendName(dst, index-1, s.morphemes.suffix)
+}
+
+// scaleName returns the name of 1000^index for index >= 2.
+func (s *scaleNamer) scaleName(index int, system ScaleSystem) string {
+	return string(s.appendScaleName(nil, index, system))
+}
+
+// table returns the scale names up to 1000^(size-1) in the system, with
+// thousand as the name of 1000.
+func (s *scaleNamer) table(thousand string, size int, system ScaleSystem) []string {
+	t := []string{"", thousand}
+	for i := 2; i < size; i++ {
+		t = append(t, s.scaleName(i, system))
+	}
+	return t
+}
+
+// appendName appends the name built from m >= 1 followed by suffix.
+func (s *scaleNamer) appendName(dst []byte, m int, suffix string) []byte {
+	var groups [7]int
+	count := 0
+	for ; m > 0; m /= 1000 {
+		groups[count] = m % 1000
+		count++
+	}
+	for i := count - 1; i >= 0; i-- {
+		if i < count-1 {
+			dst = append(dst, s.morphemes.link...)
+		}
+		dst = append(dst, s.groupNames[groups[i]]...)
+	}
+	return append(dst, suffix...)
+}
+
+// name returns the short-scale name of 1000^(m+1) for m >= 1.
+func (s *scaleNamer) name(m int) string {
+	return string(s.appendName(nil, m, s.morphemes.suffix))
+}
+
+// groupName names a group from 0 to 999 with its final vowel dropped. A
+// single digit is named by its stem, also within a longer name.
+func (s *scaleNamer) groupName(g int) string {
+	m := &s.morphemes
+	if g == 0 {
+		return m.zero
+	}
+	if g < 10 {
+		return m.stems[g]
+	}
+
+	u, t, h := g%10, g/10%10, g/100
+	marks := hundredsMarks[h]
+	if t > 0 {
+		marks = tensMarks[t]
+	}
+	unit := m.units[u]
+	for i := 0; i < len(marks); i++ {
+		if form := m.marked[marks[i]][u]; form != "" {
+			unit = form
+			break
+		}
+	}
+
+	name := unit + m.tens[t] + m.hundreds[h]
+	if r, size := utf8.DecodeLastRuneInString(name); strings.ContainsRune(m.vowels, r) {
+		name = name[:len(name)-size]
+	}
+	return name
+}
+
+// parseScale reads a generated name in the system, returning its index.
+// Only names spelled exactly as appendScaleName writes them are accepted.
+func (s *scaleNamer) parseScale(word string, system ScaleSystem) (int, bool) {
+	if system == LongScale {
+		if m, ok := s.parse(word, s.morphemes.longSuffix); ok {
+			return 2*m + 1, true
+		}
+		if m, ok := s.parse(word, s.morphemes.suffix); ok {
+			return 2 * m, true
+		}
+		return 0, false
+	}
+	m, ok := s.parse(word, s.morphemes.suffix)
+	return m + 1, ok
+}
+
+// parse reads a name built by appendName with the suffix, returning m.
+func (s *scaleNamer) parse(word, suffix string) (int, bool) {
+	rest, ok := strings.CutSuffix(word, suffix)
+	if !ok || rest == "" {
+		return 0, false
+	}
+	parts := strings.Split(rest, s.morphemes.link)
+	if len(parts) > maxScaleGroups {
+		return 0, false
+	}
+
+	m := 0
+	for _, part := range parts {
+		g, ok := s.groups[part]
+		if !ok {
+			return 0, false
+		}
+		m = m*1000 + g
+	}
+	if m == 0 || string(s.appendName(nil, m, suffix)) != word {
+		return 0, false
+	}
+	return m, true
+}
+
+// stackIndex returns the scale index from which numbers are written by
+// repeating the largest scale of the table.
+func (c *Converter) stackIndex() int {
+	if c.stackScales || c.names == nil {
+		return len(c.scales) - 1
+	}
+	return math.MaxInt
+}
+
+// appendScale appends the name of 1000^index.
+func (c *Converter) appendScale(dst []byte, index int) []byte {
+	if index < len(c.scales) {
+		return append(dst, c.scales[index]...)
+	}
+	return c.names.appendScaleName(dst, index, c.scaleSystem)
+}
+
+// scaleName returns the name of 1000^index.
+func (c *Converter) scaleName(index int) string {
+	if index < len(c.scales) {
+		return c.scales[index]
+	}
+	return c.names.scaleName(index, c.scaleSystem)
+}
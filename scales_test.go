@@ -0,0 +1,172 @@
+package num2persian
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestScaleNames_MatchTable(t *testing.T) {
+	table := persianScaleNamer.table(scales[1], len(scales), IranianScale)
+	for i := range scales {
+		if table[i] != scales[i] {
+			t.Errorf("generated name of 1000^%d = %q, want %q", i, table[i], scales[i])
+		}
+	}
+	if name := persianScaleNamer.scaleName(3, ShortScale); name != "بیلیون" {
+		t.Errorf("short-scale name of 1000^3 = %q, want %q", name, "بیلیون")
+	}
+}
+
+func TestScaleNames(t *testing.T) {
+	tests := []struct {
+		m        int
+		expected string
+	}{
+		{11, "آندسیلیون"},
+		{12, "دودسیلیون"},
+		{13, "ترهدسیلیون"},
+		{16, "سدسیلیون"},
+		{17, "سپتندسیلیون"},
+		{19, "نووندسیلیون"},
+		{20, "ویجینتیلیون"},
+		{21, "آنویجینتیلیون"},
+		{23, "ترسویجینتیلیون"},
+		{26, "سسویجینتیلیون"},
+		{27, "سپتمویجینتیلیون"},
+		{30, "تریجینتیلیون"},
+		{86, "سکساکتوجینتیلیون"},
+		{100, "سنتیلیون"},
+		{102, "دوئوسنتیلیون"},
+		{103, "ترسسنتیلیون"},
+		{200, "دوسنتیلیون"},
+		{603, "ترهسسنتیلیون"},
+		{999, "نووهنوناجینتانونجنتیلیون"},
+		{1000, "میلینیلیون"},
+		{1001, "میلیمیلیون"},
+		{10000, "دسیلینیلیون"},
+		{10010, "دسیلیدسیلیون"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if name := persianScaleNamer.name(tt.m); name != tt.expected {
+				t.Errorf("name(%d) = %q, want %q", tt.m, name, tt.expected)
+			}
+		})
+	}
+}
+
+func TestScaleNames_Distinct(t *testing.T) {
+	for _, names := range []*scaleNamer{persianScaleNamer, dariScaleNamer} {
+		if n := len(names.groups); n != 1000 {
+			t.Fatalf("group names of %q map %d groups, want 1000", names.morphemes.suffix, n)
+		}
+	}
+}
+
+func TestScaleNames_Parse(t *testing.T) {
+	for m := 1; m < 20000; m++ {
+		name := persianScaleNamer.name(m)
+		if got, ok := persianScaleNamer.parseScale(name, ShortScale); !ok || got != m+1 {
+			t.Fatalf("parseScale(%q) = %d, %v, want %d", name, got, ok, m+1)
+		}
+	}
+
+	for _, word := range []string{"", "یلیون", "نیلیون", "نیلیمیلیون", "میلیمیلیمیلیون", "سیب", "دسیلیو"} {
+		if index, ok := persianScaleNamer.parseScale(word, ShortScale); ok {
+			t.Errorf("parseScale(%q) = %d, want no match", word, index)
+		}
+	}
+}
+
+func TestParseBigInt_GeneratedScalesRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		n := new(big.Int).Rand(rng, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(1+rng.Intn(400))), nil))
+		text := ConvertBigInt(n)
+		result, err := ParseBigInt(text)
+		if err != nil {
+			t.Fatalf("ParseBigInt(%q) unexpected error: %v", text, err)
+		}
+		if result.Cmp(n) != 0 {
+			t.Fatalf("ParseBigInt(ConvertBigInt(%s)) = %s", n, result)
+		}
+	}
+}
+
+func TestScaleSystem(t *testing.T) {
+	tests := []struct {
+		system   ScaleSystem
+		exp      int64
+		expected string
+	}{
+		{IranianScale, 9, "یک میلیارد"},
+		{IranianScale, 12, "یک تریلیون"},
+		{ShortScale, 9, "یک بیلیون"},
+		{ShortScale, 12, "یک تریلیون"},
+		{ShortScale, 36, "یک آندسیلیون"},
+		{LongScale, 6, "یک میلیون"},
+		{LongScale, 9, "یک میلیارد"},
+		{LongScale, 12, "یک بیلیون"},
+		{LongScale, 15, "یک بیلیارد"},
+		{LongScale, 18, "یک تریلیون"},
+		{LongScale, 21, "یک تریلیارد"},
+		{LongScale, 60, "یک دسیلیون"},
+		{LongScale, 63, "یک دسیلیارد"},
+		{LongScale, 66, "یک آندسیلیون"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			c := NewConverter(Options{ScaleSystem: tt.system})
+			n := new(big.Int).Exp(big.NewInt(10), big.NewInt(tt.exp), nil)
+			if result := c.ConvertBigInt(n); result != tt.expected {
+				t.Errorf("ConvertBigInt(10^%d) = %q, want %q", tt.exp, result, tt.expected)
+			}
+			result, err := c.ParseBigInt(tt.expected)
+			if err != nil {
+				t.Fatalf("ParseBigInt(%q) unexpected error: %v", tt.expected, err)
+			}
+			if result.Cmp(n) != 0 {
+				t.Errorf("ParseBigInt(%q) = %s, want 10^%d", tt.expected, result, tt.exp)
+			}
+		})
+	}
+}
+
+func TestScaleSystem_Uint64(t *testing.T) {
+	c := NewConverter(Options{ScaleSystem: LongScale})
+	result := c.Convert(2500000000000)
+	expected := "دو بیلیون و پانصد میلیارد"
+	if result != expected {
+		t.Errorf("Convert(2500000000000) = %q, want %q", result, expected)
+	}
+}
+
+func TestScaleSystem_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, system := range []ScaleSystem{ShortScale, LongScale} {
+		c := NewConverter(Options{ScaleSystem: system})
+		for i := 0; i < 100; i++ {
+			n := new(big.Int).Rand(rng, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(1+rng.Intn(400))), nil))
+			text := c.ConvertBigInt(n)
+			result, err := c.ParseBigInt(text)
+			if err != nil {
+				t.Fatalf("ParseBigInt(%q) unexpected error: %v", text, err)
+			}
+			if result.Cmp(n) != 0 {
+				t.Fatalf("ParseBigInt(ConvertBigInt(%s)) = %s", n, result)
+			}
+		}
+	}
+}
+
+func TestScaleSystem_StackScales(t *testing.T) {
+	c := NewConverter(Options{ScaleSystem: LongScale, StackScales: true})
+	n := new(big.Int).Exp(big.NewInt(10), big.NewInt(36), nil)
+	expected := "هزار کوینتیلیارد"
+	if result := c.ConvertBigInt(n); result != expected {
+		t.Errorf("ConvertBigInt(10^36) = %q, want %q", result, expected)
+	}
+}
@@ -0,0 +1,156 @@
+package num2persian
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Unit is the currency unit of an amount.
+type Unit int
+
+const (
+	Rial Unit = iota
+	Toman
+)
+
+func (u Unit) String() string {
+	if u == Toman {
+		return tomanUnit
+	}
+	return rialUnit
+}
+
+// rialsPerToman is the number of Rials in one Toman.
+const rialsPerToman = 10
+
+// unitWords maps the unit words accepted in amount text to their unit.
+var unitWords = map[string]Unit{
+	rialUnit:  Rial,
+	tomanUnit: Toman,
+	"تومن":    Toman,
+}
+
+// AmountReport describes how the words of an amount compare with its digits.
+type AmountReport struct {
+	// Match reports whether the words and the amount agree.
+	Match bool
+	// Expected is the amount written out in its own unit.
+	Expected string
+	// Parsed is the value read from the words, in the unit of the words.
+	// It is nil when the words could not be parsed.
+	Parsed *big.Int
+	// Unit is the unit written in the words, or the amount's unit when the
+	// words name none; UnitFound reports which.
+	Unit      Unit
+	UnitFound bool
+	// Diff is the most significant three-digit group in which the values
+	// differ, or nil when they match.
+	Diff *GroupDiff
+	// Corrections lists the changes made to the words before reading them,
+	// as reported by ParseLenient.
+	Corrections []Correction
+}
+
+// GroupDiff is a three-digit group in which two values differ. Both values
+// are expressed in the amount's unit when the words use the same unit, and
+// in Rial otherwise.
+type GroupDiff struct {
+	// Scale is the group's scale index: 0 for units, 1 for thousands, ...
+	Scale    int
+	Expected int
+	Parsed   int
+}
+
+// VerifyAmount checks that amount words, as written on a cheque, agree with
+// the numeric amount. The amount is in Rial unless a unit is given. The words
+// may use ZWNJ or spaces freely, Arabic letter forms, common spelling
+// variants, commas in place of "و", the "فقط ... تمام" wrapping and a
+// "ریال" or "تومان" unit, which is converted when it differs from the
+// amount's unit. The words are read as ParseLenient reads them, and the
+// corrections made are listed in the report.
+//
+// The returned report is never nil. The error is a *ParseError when the
+// words cannot be read as a number.
+func VerifyAmount(words string, amount *big.Int, unit ...Unit) (*AmountReport, error) {
+	if amount == nil {
+		amount = new(big.Int)
+	}
+	amountUnit := Rial
+	if len(unit) > 0 {
+		amountUnit = unit[0]
+	}
+
+	report := &AmountReport{
+		Expected: ConvertBigInt(amount) + " " + amountUnit.String(),
+		Unit:     amountUnit,
+	}
+
+	tokens, corrections := defaultConverter.normalizeWords(words)
+	report.Corrections = corrections
+	if len(tokens) > 0 && tokens[0] == chequePrefix {
+		tokens = tokens[1:]
+	}
+	if n := len(tokens); n > 0 && tokens[n-1] == chequeSuffix {
+		tokens = tokens[:n-1]
+	}
+	if n := len(tokens); n > 0 {
+		if u, ok := unitWords[tokens[n-1]]; ok {
+			report.Unit, report.UnitFound = u, true
+			tokens = tokens[:n-1]
+		}
+	}
+
+	parsed, err := defaultConverter.parseWords(strings.Join(tokens, " "), bigIntLimit)
+	if err != nil {
+		if perr, ok := err.(*ParseError); ok {
+			perr.Input = words
+		}
+		return report, err
+	}
+	report.Parsed = parsed
+
+	expected, got := amount, parsed
+	if report.Unit != amountUnit {
+		expected, got = toRials(amount, amountUnit), toRials(parsed, report.Unit)
+	}
+	report.Match = expected.Cmp(got) == 0
+	if !report.Match {
+		report.Diff = firstDifferingGroup(expected, got)
+	}
+	return report, nil
+}
+
+func toRials(n *big.Int, u Unit) *big.Int {
+	if u == Toman {
+		return new(big.Int).Mul(n, big.NewInt(rialsPerToman))
+	}
+	return n
+}
+
+// firstDifferingGroup compares the magnitudes of a and b group by group from
+// the most significant end. When only the signs differ it reports the most
+// significant group of a.
+func firstDifferingGroup(a, b *big.Int) *GroupDiff {
+	x := new(big.Int).Abs(a).String()
+	y := new(big.Int).Abs(b).String()
+
+	width := max(len(x), len(y))
+	width += (3 - width%3) % 3
+	x = strings.Repeat("0", width-len(x)) + x
+	y = strings.Repeat("0", width-len(y)) + y
+
+	groups := width / 3
+	for i := 0; i < groups; i++ {
+		gx, gy := atoiGroup(x[i*3:i*3+3]), atoiGroup(y[i*3:i*3+3])
+		if gx != gy {
+			return &GroupDiff{Scale: groups - 1 - i, Expected: gx, Parsed: gy}
+		}
+	}
+
+	for i := 0; i < groups; i++ {
+		if gx := atoiGroup(x[i*3 : i*3+3]); gx != 0 {
+			return &GroupDiff{Scale: groups - 1 - i, Expected: gx, Parsed: gx}
+		}
+	}
+	return &GroupDiff{}
+}
@@ -0,0 +1,120 @@
+package num2persian
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestVerifyAmount_Match(t *testing.T) {
+	tests := []struct {
+		name   string
+		words  string
+		amount int64
+		unit   []Unit
+	}{
+		{"Plain", "یک میلیون و پانصد هزار", 1500000, nil},
+		{"RialUnit", "یک میلیون و پانصد هزار ریال", 1500000, nil},
+		{"Cheque", "فقط یک میلیون و یک هزار ریال تمام", 1001000, nil},
+		{"TomanToRial", "صد هزار تومان", 1000000, nil},
+		{"RialToToman", "یک میلیون ریال", 100000, []Unit{Toman}},
+		{"ArabicLetters", "يك ميليون", 1000000, nil},
+		{"ZWNJ", "ده‌هزار", 10000, nil},
+		{"ExtraSpaces", "  دو   هزار  و   پانصد  ", 2500, nil},
+		{"Commas", "یک میلیون، پانصد هزار", 1500000, nil},
+		{"Variant", "هیجده هزار", 18000, nil},
+		{"Yeksad", "یکصد هزار", 100000, nil},
+		{"SplitHundreds", "سه صد و پنج", 305, nil},
+		{"Typo", "دو ملیون", 2000000, nil},
+		{"ColloquialToman", "پنجاه تومن", 50, []Unit{Toman}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := VerifyAmount(tt.words, big.NewInt(tt.amount), tt.unit...)
+			if err != nil {
+				t.Fatalf("VerifyAmount(%q) unexpected error: %v", tt.words, err)
+			}
+			if !report.Match {
+				t.Errorf("VerifyAmount(%q, %d) did not match: parsed %s, diff %+v", tt.words, tt.amount, report.Parsed, report.Diff)
+			}
+			if report.Diff != nil {
+				t.Errorf("VerifyAmount(%q, %d) Diff = %+v, want nil", tt.words, tt.amount, report.Diff)
+			}
+		})
+	}
+}
+
+func TestVerifyAmount_Corrections(t *testing.T) {
+	report, err := VerifyAmount("فقط يكهزار و هیجده ریال تمام", big.NewInt(1018))
+	if err != nil {
+		t.Fatalf("VerifyAmount unexpected error: %v", err)
+	}
+	expected := []Correction{
+		{LetterCorrection, "يكهزار", "یکهزار"},
+		{SpacingCorrection, "یکهزار", "یک هزار"},
+		{SpellingCorrection, "هیجده", "هجده"},
+	}
+	if !report.Match || !reflect.DeepEqual(report.Corrections, expected) {
+		t.Errorf("report = %+v, want a match with corrections %+v", report, expected)
+	}
+}
+
+func TestVerifyAmount_Mismatch(t *testing.T) {
+	report, err := VerifyAmount("فقط یک میلیون و چهارصد هزار ریال تمام", big.NewInt(1500000))
+	if err != nil {
+		t.Fatalf("VerifyAmount unexpected error: %v", err)
+	}
+	if report.Match {
+		t.Fatal("VerifyAmount reported a match for different values")
+	}
+	if report.Expected != "یک میلیون و پانصد هزار ریال" {
+		t.Errorf("Expected = %q", report.Expected)
+	}
+	if report.Parsed.Int64() != 1400000 {
+		t.Errorf("Parsed = %s, want 1400000", report.Parsed)
+	}
+	if report.Unit != Rial || !report.UnitFound {
+		t.Errorf("Unit = %v (found %v), want ریال (found true)", report.Unit, report.UnitFound)
+	}
+	expected := GroupDiff{Scale: 1, Expected: 500, Parsed: 400}
+	if report.Diff == nil || *report.Diff != expected {
+		t.Errorf("Diff = %+v, want %+v", report.Diff, expected)
+	}
+}
+
+func TestVerifyAmount_UnitMismatch(t *testing.T) {
+	report, err := VerifyAmount("یک میلیون تومان", big.NewInt(1000000))
+	if err != nil {
+		t.Fatalf("VerifyAmount unexpected error: %v", err)
+	}
+	if report.Match {
+		t.Fatal("VerifyAmount matched Toman words against the same number of Rials")
+	}
+	if report.Unit != Toman {
+		t.Errorf("Unit = %v, want %v", report.Unit, Toman)
+	}
+	expected := GroupDiff{Scale: 2, Expected: 1, Parsed: 10}
+	if report.Diff == nil || *report.Diff != expected {
+		t.Errorf("Diff = %+v, want %+v", report.Diff, expected)
+	}
+}
+
+func TestVerifyAmount_Unparsable(t *testing.T) {
+	words := "یک میلیون و سیب"
+	report, err := VerifyAmount(words, big.NewInt(1000000))
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("VerifyAmount error = %v, want *ParseError", err)
+	}
+	if perr.Input != words {
+		t.Errorf("ParseError.Input = %q, want %q", perr.Input, words)
+	}
+	if report == nil || report.Match || report.Parsed != nil {
+		t.Errorf("report = %+v, want non-matching report without Parsed", report)
+	}
+	if report.Expected != "یک میلیون ریال" {
+		t.Errorf("Expected = %q", report.Expected)
+	}
+}